@@ -0,0 +1,409 @@
+// This file contains helpers to decode the changes of audit log entries into
+// typed values, and to describe audit log entries in a human-readable way.
+
+package astatine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrAuditLogChangeType is returned when the values of an audit log change
+// cannot be decoded into the requested type.
+var ErrAuditLogChangeType = errors.New("unexpected audit log change value type")
+
+// decodeValues decodes the old and new value of an audit log change into the
+// values pointed to by oldDst and newDst. A missing value leaves its
+// destination unchanged.
+func (c *AuditLogChange) decodeValues(oldDst, newDst interface{}) error {
+	decode := func(v, dst interface{}) error {
+		if v == nil {
+			return nil
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(b, dst); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrAuditLogChangeType, c.key(), err)
+		}
+		return nil
+	}
+
+	if err := decode(c.OldValue, oldDst); err != nil {
+		return err
+	}
+	return decode(c.NewValue, newDst)
+}
+
+// key returns the key of the change, or an empty key if it has none.
+func (c *AuditLogChange) key() AuditLogChangeKey {
+	if c.Key == nil {
+		return ""
+	}
+	return *c.Key
+}
+
+// Strings returns the old and new value of a change with a string value,
+// e.g. AuditLogChangeKeyName.
+func (c *AuditLogChange) Strings() (oldValue, newValue string, err error) {
+	err = c.decodeValues(&oldValue, &newValue)
+	return
+}
+
+// Bools returns the old and new value of a change with a boolean value,
+// e.g. AuditLogChangeKeyNSFW.
+func (c *AuditLogChange) Bools() (oldValue, newValue bool, err error) {
+	err = c.decodeValues(&oldValue, &newValue)
+	return
+}
+
+// Ints returns the old and new value of a change with an integer value,
+// e.g. AuditLogChangeKeyColor.
+func (c *AuditLogChange) Ints() (oldValue, newValue int, err error) {
+	err = c.decodeValues(&oldValue, &newValue)
+	return
+}
+
+// Permissions returns the old and new permission bits of a change with the
+// key AuditLogChangeKeyPermissions, AuditLogChangeKeyAllow or
+// AuditLogChangeKeyDeny.
+func (c *AuditLogChange) Permissions() (oldValue, newValue int64, err error) {
+	var o, n json.Number
+	if err = c.decodeValues(&o, &n); err != nil {
+		return
+	}
+
+	parse := func(v json.Number) (int64, error) {
+		if v == "" {
+			return 0, nil
+		}
+		i, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s: %v", ErrAuditLogChangeType, c.key(), err)
+		}
+		return i, nil
+	}
+
+	if oldValue, err = parse(o); err != nil {
+		return
+	}
+	newValue, err = parse(n)
+	return
+}
+
+// Durations returns the old and new value of a change with a duration value,
+// e.g. AuditLogChangeKeyRateLimitPerUser. Discord sends durations in seconds,
+// minutes or days depending on the key.
+func (c *AuditLogChange) Durations() (oldValue, newValue time.Duration, err error) {
+	var unit time.Duration
+	switch c.key() {
+	case AuditLogChangeKeyAfkTimeout, AuditLogChangeKeyRateLimitPerUser, AuditLogChangeKeyMaxAge:
+		unit = time.Second
+	case AuditLogChangeKeyAutoArchiveDuration, AuditLogChangeKeyDefaultAutoArchiveDuration:
+		unit = time.Minute
+	case AuditLogChangeKeyPruneDeleteDays, AuditLogChangeKeyExpireGracePeriod:
+		unit = 24 * time.Hour
+	default:
+		err = fmt.Errorf("%w: %s is not a duration", ErrAuditLogChangeType, c.key())
+		return
+	}
+
+	var o, n int64
+	err = c.decodeValues(&o, &n)
+	return time.Duration(o) * unit, time.Duration(n) * unit, err
+}
+
+// Times returns the old and new value of a change with a timestamp value,
+// e.g. AuditLogChangeKeyCommunicationDisabledUntil. A missing timestamp is
+// returned as nil.
+func (c *AuditLogChange) Times() (oldValue, newValue *time.Time, err error) {
+	err = c.decodeValues(&oldValue, &newValue)
+	return
+}
+
+// PermissionOverwrites returns the old and new permission overwrites of a
+// change with the key AuditLogChangeKeyPermissionOverwrite.
+func (c *AuditLogChange) PermissionOverwrites() (oldValue, newValue []*PermissionOverwrite, err error) {
+	err = c.decodeValues(&oldValue, &newValue)
+	return
+}
+
+// Roles returns the partial roles which were added to or removed from a
+// member by a change with the key AuditLogChangeKeyRoleAdd or
+// AuditLogChangeKeyRoleRemove.
+func (c *AuditLogChange) Roles() ([]*Role, error) {
+	var roles []*Role
+	err := c.decodeValues(new([]*Role), &roles)
+	return roles, err
+}
+
+// Values returns the old and new value of a change decoded into the type of
+// its key: []*Role for role changes, []*PermissionOverwrite for permission
+// overwrites, int64 for permissions, time.Duration for durations, *time.Time
+// for timestamps, and bool, int or string for other known keys. Values of
+// unknown keys are returned as decoded from JSON.
+func (c *AuditLogChange) Values() (oldValue, newValue interface{}, err error) {
+	switch c.key() {
+	case AuditLogChangeKeyRoleAdd, AuditLogChangeKeyRoleRemove:
+		var roles []*Role
+		roles, err = c.Roles()
+		return nil, roles, err
+	case AuditLogChangeKeyPermissionOverwrite:
+		o, n, err := c.PermissionOverwrites()
+		return o, n, err
+	case AuditLogChangeKeyPermissions, AuditLogChangeKeyAllow, AuditLogChangeKeyDeny:
+		o, n, err := c.Permissions()
+		return o, n, err
+	case AuditLogChangeKeyAfkTimeout, AuditLogChangeKeyRateLimitPerUser, AuditLogChangeKeyMaxAge, AuditLogChangeKeyExpireGracePeriod,
+		AuditLogChangeKeyAutoArchiveDuration, AuditLogChangeKeyDefaultAutoArchiveDuration, AuditLogChangeKeyPruneDeleteDays:
+		o, n, err := c.Durations()
+		return o, n, err
+	case AuditLogChangeKeyCommunicationDisabledUntil:
+		o, n, err := c.Times()
+		return o, n, err
+	case AuditLogChangeKeyArchived, AuditLogChangeKeyAvailable, AuditLogChangeKeyDeaf, AuditLogChangeKeyEnableEmoticons,
+		AuditLogChangeKeyHoist, AuditLogChangeKeyInvitable, AuditLogChangeKeyLocked, AuditLogChangeKeyMentionable,
+		AuditLogChangeKeyMute, AuditLogChangeKeyNSFW, AuditLogChangeKeyTemporary, AuditLogChangeKeyWidgetEnabled:
+		o, n, err := c.Bools()
+		return o, n, err
+	case AuditLogChangeKeyBitrate, AuditLogChangeKeyColor, AuditLogChangeKeyDefaultMessageNotification, AuditLogChangeKeyEntityType,
+		AuditLogChangeKeyExpireBehavior, AuditLogChangeKeyExplicitContentFilter, AuditLogChangeKeyFormatType, AuditLogChangeKeyMaxUses,
+		AuditLogChangeKeyMfaLevel, AuditLogChangeKeyPosition, AuditLogChangeKeyPrivacylevel, AuditLogChangeKeyStatus,
+		AuditLogChangeKeyUserLimit, AuditLogChangeKeyUses, AuditLogChangeKeyVerificationLevel:
+		o, n, err := c.Ints()
+		return o, n, err
+	case AuditLogChangeKeyType:
+		return c.OldValue, c.NewValue, nil
+	default:
+		if _, ok := c.NewValue.(string); ok || c.NewValue == nil {
+			if _, ok := c.OldValue.(string); ok || c.OldValue == nil {
+				o, n, err := c.Strings()
+				return o, n, err
+			}
+		}
+		return c.OldValue, c.NewValue, nil
+	}
+}
+
+// auditLogTarget is the kind of entity which the target ID of an audit log
+// entry refers to.
+type auditLogTarget int
+
+const (
+	auditLogTargetNone auditLogTarget = iota
+	auditLogTargetID
+	auditLogTargetChannel
+	auditLogTargetUser
+	auditLogTargetRole
+	auditLogTargetEmoji
+	auditLogTargetSticker
+)
+
+// auditLogActions describes each audit log action and the kind of its target.
+var auditLogActions = map[AuditLogAction]struct {
+	description string
+	target      auditLogTarget
+}{
+	AuditLogActionGuildUpdate: {"updated the server", auditLogTargetNone},
+
+	AuditLogActionChannelCreate:          {"created channel", auditLogTargetChannel},
+	AuditLogActionChannelUpdate:          {"updated channel", auditLogTargetChannel},
+	AuditLogActionChannelDelete:          {"deleted channel", auditLogTargetChannel},
+	AuditLogActionChannelOverwriteCreate: {"created a permission overwrite in", auditLogTargetChannel},
+	AuditLogActionChannelOverwriteUpdate: {"updated a permission overwrite in", auditLogTargetChannel},
+	AuditLogActionChannelOverwriteDelete: {"deleted a permission overwrite in", auditLogTargetChannel},
+
+	AuditLogActionMemberKick:       {"kicked", auditLogTargetUser},
+	AuditLogActionMemberPrune:      {"pruned members", auditLogTargetNone},
+	AuditLogActionMemberBanAdd:     {"banned", auditLogTargetUser},
+	AuditLogActionMemberBanRemove:  {"unbanned", auditLogTargetUser},
+	AuditLogActionMemberUpdate:     {"updated member", auditLogTargetUser},
+	AuditLogActionMemberRoleUpdate: {"updated the roles of", auditLogTargetUser},
+	AuditLogActionMemberMove:       {"moved members", auditLogTargetNone},
+	AuditLogActionMemberDisconnect: {"disconnected members", auditLogTargetNone},
+	AuditLogActionBotAdd:           {"added bot", auditLogTargetUser},
+
+	AuditLogActionRoleCreate: {"created role", auditLogTargetRole},
+	AuditLogActionRoleUpdate: {"updated role", auditLogTargetRole},
+	AuditLogActionRoleDelete: {"deleted role", auditLogTargetRole},
+
+	AuditLogActionInviteCreate: {"created an invite", auditLogTargetNone},
+	AuditLogActionInviteUpdate: {"updated an invite", auditLogTargetNone},
+	AuditLogActionInviteDelete: {"deleted an invite", auditLogTargetNone},
+
+	AuditLogActionWebhookCreate: {"created webhook", auditLogTargetID},
+	AuditLogActionWebhookUpdate: {"updated webhook", auditLogTargetID},
+	AuditLogActionWebhookDelete: {"deleted webhook", auditLogTargetID},
+
+	AuditLogActionEmojiCreate: {"created emoji", auditLogTargetEmoji},
+	AuditLogActionEmojiUpdate: {"updated emoji", auditLogTargetEmoji},
+	AuditLogActionEmojiDelete: {"deleted emoji", auditLogTargetEmoji},
+
+	AuditLogActionMessageDelete:     {"deleted a message of", auditLogTargetUser},
+	AuditLogActionMessageBulkDelete: {"bulk deleted messages in", auditLogTargetChannel},
+	AuditLogActionMessagePin:        {"pinned a message of", auditLogTargetUser},
+	AuditLogActionMessageUnpin:      {"unpinned a message of", auditLogTargetUser},
+
+	AuditLogActionIntegrationCreate:   {"created integration", auditLogTargetID},
+	AuditLogActionIntegrationUpdate:   {"updated integration", auditLogTargetID},
+	AuditLogActionIntegrationDelete:   {"deleted integration", auditLogTargetID},
+	AuditLogActionStageInstanceCreate: {"started stage", auditLogTargetID},
+	AuditLogActionStageInstanceUpdate: {"updated stage", auditLogTargetID},
+	AuditLogActionStageInstanceDelete: {"ended stage", auditLogTargetID},
+
+	AuditLogActionStickerCreate: {"created sticker", auditLogTargetSticker},
+	AuditLogActionStickerUpdate: {"updated sticker", auditLogTargetSticker},
+	AuditLogActionStickerDelete: {"deleted sticker", auditLogTargetSticker},
+
+	AuditLogGuildScheduledEventCreate: {"created scheduled event", auditLogTargetID},
+	AuditLogGuildScheduledEventUpdare: {"updated scheduled event", auditLogTargetID},
+	AuditLogGuildScheduledEventDelete: {"deleted scheduled event", auditLogTargetID},
+
+	AuditLogActionThreadCreate: {"created thread", auditLogTargetChannel},
+	AuditLogActionThreadUpdate: {"updated thread", auditLogTargetChannel},
+	AuditLogActionThreadDelete: {"deleted thread", auditLogTargetChannel},
+
+	AuditLogActionAutoModerationRuleCreate:                {"created auto moderation rule", auditLogTargetID},
+	AuditLogActionAutoModerationRuleUpdate:                {"updated auto moderation rule", auditLogTargetID},
+	AuditLogActionAutoModerationRuleDelete:                {"deleted auto moderation rule", auditLogTargetID},
+	AuditLogActionAutoModerationBlockMessage:              {"blocked a message of", auditLogTargetUser},
+	AuditLogActionAutoModerationFlagToChannel:             {"flagged a message of", auditLogTargetUser},
+	AuditLogActionAutoModerationUserCommunicationDisabled: {"timed out", auditLogTargetUser},
+}
+
+// Summary describes an audit log entry in a human-readable way, e.g.
+//
+//	user#0001 updated role @Moderators: color: 0 → 16711680, hoist: false → true
+//
+// The user who made the change and the target of the entry are resolved
+// against the state of the guild, and shown as IDs when they are not in the
+// state. state can be nil.
+func (e *AuditLogEntry) Summary(state *State, guildID string) string {
+	var sb strings.Builder
+	sb.WriteString(auditLogUserName(state, guildID, e.UserID))
+
+	var action AuditLogAction
+	if e.ActionType != nil {
+		action = *e.ActionType
+	}
+	a, ok := auditLogActions[action]
+	if !ok {
+		a.description = "made an unknown change to"
+		a.target = auditLogTargetID
+	}
+	sb.WriteString(" " + a.description)
+
+	if target := auditLogTargetName(state, guildID, a.target, e.TargetID); target != "" {
+		sb.WriteString(" " + target)
+	}
+
+	for i, c := range e.Changes {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(auditLogChangeSummary(c))
+	}
+
+	if e.Reason != "" {
+		sb.WriteString(" (reason: " + e.Reason + ")")
+	}
+
+	return sb.String()
+}
+
+// auditLogUserName returns the name of a user in a guild, or their ID if the
+// member is not in the state.
+func auditLogUserName(state *State, guildID, userID string) string {
+	if userID == "" {
+		return "Discord"
+	}
+	if m, err := state.Member(guildID, userID); err == nil && m.User != nil {
+		return m.User.String()
+	}
+	return "user " + userID
+}
+
+// auditLogTargetName returns the name of the target of an audit log entry.
+func auditLogTargetName(state *State, guildID string, target auditLogTarget, targetID string) string {
+	if targetID == "" || target == auditLogTargetNone {
+		return ""
+	}
+
+	switch target {
+	case auditLogTargetChannel:
+		if c, err := state.Channel(targetID); err == nil {
+			return "#" + c.Name
+		}
+	case auditLogTargetUser:
+		return auditLogUserName(state, guildID, targetID)
+	case auditLogTargetRole:
+		if r, err := state.Role(guildID, targetID); err == nil {
+			return "@" + r.Name
+		}
+	case auditLogTargetEmoji:
+		if e, err := state.Emoji(guildID, targetID); err == nil {
+			return ":" + e.Name + ":"
+		}
+	case auditLogTargetSticker:
+		if s, err := state.Sticker(guildID, targetID); err == nil {
+			return s.Name
+		}
+	}
+
+	return targetID
+}
+
+// auditLogChangeSummary describes a change of an audit log entry.
+func auditLogChangeSummary(c *AuditLogChange) string {
+	oldValue, newValue, err := c.Values()
+	if err != nil {
+		return fmt.Sprintf("%s: %v → %v", c.key(), c.OldValue, c.NewValue)
+	}
+
+	switch c.key() {
+	case AuditLogChangeKeyRoleAdd:
+		return "added " + auditLogRoleNames(newValue.([]*Role))
+	case AuditLogChangeKeyRoleRemove:
+		return "removed " + auditLogRoleNames(newValue.([]*Role))
+	}
+
+	return fmt.Sprintf("%s: %s → %s", c.key(), auditLogValueString(c.OldValue == nil, oldValue), auditLogValueString(c.NewValue == nil, newValue))
+}
+
+// auditLogRoleNames returns the mentions of the names of roles.
+func auditLogRoleNames(roles []*Role) string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = "@" + r.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// auditLogValueString formats a typed value of an audit log change.
+func auditLogValueString(missing bool, v interface{}) string {
+	if missing {
+		return "none"
+	}
+
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case *time.Time:
+		if v == nil {
+			return "none"
+		}
+		return v.Format(time.RFC3339)
+	case []*PermissionOverwrite:
+		return fmt.Sprintf("%d overwrites", len(v))
+	default:
+		return fmt.Sprint(v)
+	}
+}
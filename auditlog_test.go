@@ -0,0 +1,96 @@
+package astatine
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAuditLogChangeValues(t *testing.T) {
+	var log GuildAuditLog
+	err := json.Unmarshal([]byte(`{"audit_log_entries": [{"changes": [
+		{"key": "$add", "new_value": [{"id": "3", "name": "Moderators"}]},
+		{"key": "rate_limit_per_user", "old_value": 0, "new_value": 30},
+		{"key": "auto_archive_duration", "old_value": 60, "new_value": 1440},
+		{"key": "permissions", "old_value": "0", "new_value": "8"},
+		{"key": "communication_disabled_until", "new_value": "2022-01-01T00:00:00+00:00"},
+		{"key": "permission_overwrites", "new_value": [{"id": "3", "type": 0, "allow": "1024", "deny": "0"}]},
+		{"key": "nsfw", "old_value": false, "new_value": true},
+		{"key": "name", "old_value": "old", "new_value": "new"}
+	]}]}`), &log)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	changes := log.AuditLogEntries[0].Changes
+
+	roles, err := changes[0].Roles()
+	if err != nil || len(roles) != 1 || roles[0].Name != "Moderators" {
+		t.Errorf("Roles returned %v, %v", roles, err)
+	}
+
+	until := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, want := range [][2]interface{}{
+		{nil, roles},
+		{time.Duration(0), 30 * time.Second},
+		{time.Hour, 24 * time.Hour},
+		{int64(0), int64(8)},
+		{(*time.Time)(nil), &until},
+		{[]*PermissionOverwrite(nil), []*PermissionOverwrite{{ID: "3", Allow: 1024}}},
+		{false, true},
+		{"old", "new"},
+	} {
+		oldValue, newValue, err := changes[i].Values()
+		if err != nil {
+			t.Errorf("Values of %s returned error: %v", *changes[i].Key, err)
+			continue
+		}
+		if t1, ok := newValue.(*time.Time); ok && t1.Equal(until) {
+			newValue = &until
+		}
+		if !reflect.DeepEqual(oldValue, want[0]) || !reflect.DeepEqual(newValue, want[1]) {
+			t.Errorf("Values of %s are %#v, %#v, want %#v, %#v", *changes[i].Key, oldValue, newValue, want[0], want[1])
+		}
+	}
+
+	if _, _, err = changes[7].Durations(); !errors.Is(err, ErrAuditLogChangeType) {
+		t.Errorf("Durations of name returned %v, want %v", err, ErrAuditLogChangeType)
+	}
+	if _, _, err = changes[7].Bools(); !errors.Is(err, ErrAuditLogChangeType) {
+		t.Errorf("Bools of name returned %v, want %v", err, ErrAuditLogChangeType)
+	}
+}
+
+func TestAuditLogEntrySummary(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+	state.OnInterface(s, &GuildCreate{&Guild{
+		ID:      "1",
+		Roles:   []*Role{{ID: "3", Name: "Moderators"}},
+		Members: []*Member{{GuildID: "1", User: &User{ID: "2", Username: "mod", Discriminator: "0001"}}},
+	}})
+
+	action := AuditLogActionRoleUpdate
+	color, hoist := AuditLogChangeKeyColor, AuditLogChangeKeyHoist
+	entry := &AuditLogEntry{
+		TargetID:   "3",
+		UserID:     "2",
+		ActionType: &action,
+		Reason:     "rebrand",
+		Changes: []*AuditLogChange{
+			{Key: &color, OldValue: float64(0), NewValue: float64(16711680)},
+			{Key: &hoist, OldValue: false, NewValue: true},
+		},
+	}
+
+	want := "mod#0001 updated role @Moderators: color: 0 → 16711680, hoist: false → true (reason: rebrand)"
+	if got := entry.Summary(state, "1"); got != want {
+		t.Errorf("Summary is %q, want %q", got, want)
+	}
+
+	want = "user 2 updated role 3: color: 0 → 16711680, hoist: false → true (reason: rebrand)"
+	if got := entry.Summary(nil, "1"); got != want {
+		t.Errorf("Summary without state is %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,828 @@
+// This file contains the codecs used to encode and decode the payloads sent
+// over the Discord gateway websocket connection.
+
+package astatine
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/gorilla/websocket"
+)
+
+// A GatewayCodec encodes and decodes the payloads of a gateway connection.
+// Whichever encoding is used on the wire, decoded events keep their data as
+// JSON in Event.RawData, so event handlers work the same with every codec.
+type GatewayCodec interface {
+	// Encoding returns the name of the encoding, as it is passed to the
+	// gateway in the encoding query parameter.
+	Encoding() string
+
+	// Marshal encodes v, returning the websocket message type and the data
+	// to send.
+	Marshal(v interface{}) (messageType int, data []byte, err error)
+
+	// Unmarshal decodes a payload into e.
+	Unmarshal(data []byte, e *Event) error
+}
+
+// Known gateway codecs.
+var (
+	// GatewayCodecJSON encodes payloads as JSON, which is the default.
+	GatewayCodecJSON GatewayCodec = jsonCodec{}
+
+	// GatewayCodecETF encodes payloads in the Erlang External Term Format.
+	GatewayCodecETF GatewayCodec = etfCodec{}
+)
+
+// ErrETFInvalid is returned when an ETF payload can't be decoded.
+var ErrETFInvalid = errors.New("invalid etf payload")
+
+// gatewayCodec returns the codec used for the gateway connection.
+func (s *Session) gatewayCodec() GatewayCodec {
+	if s.GatewayCodec == nil {
+		return GatewayCodecJSON
+	}
+	return s.GatewayCodec
+}
+
+// writeGateway encodes v with the session's gateway codec and writes it to
+// wsConn. The caller must hold s.wsMutex.
+func (s *Session) writeGateway(wsConn *websocket.Conn, v interface{}) error {
+	mt, data, err := s.gatewayCodec().Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return wsConn.WriteMessage(mt, data)
+}
+
+// jsonCodec is the GatewayCodec for JSON encoded payloads.
+type jsonCodec struct{}
+
+func (jsonCodec) Encoding() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v interface{}) (int, []byte, error) {
+	data, err := json.Marshal(v)
+	return websocket.TextMessage, data, err
+}
+
+func (jsonCodec) Unmarshal(data []byte, e *Event) error {
+	return json.Unmarshal(data, e)
+}
+
+// ETF term tags.
+// https://www.erlang.org/doc/apps/erts/erl_ext_dist.html
+const (
+	etfVersion          = 131
+	etfCompressed       = 80
+	etfNewFloat         = 70
+	etfSmallInteger     = 97
+	etfInteger          = 98
+	etfFloat            = 99
+	etfAtom             = 100
+	etfSmallTuple       = 104
+	etfLargeTuple       = 105
+	etfNil              = 106
+	etfString           = 107
+	etfList             = 108
+	etfBinary           = 109
+	etfSmallBig         = 110
+	etfLargeBig         = 111
+	etfSmallAtom        = 115
+	etfMap              = 116
+	etfAtomUTF8         = 118
+	etfSmallAtomUTF8    = 119
+	etfMaxSmallIntegerN = math.MaxUint8
+)
+
+// etfCodec is the GatewayCodec for ETF encoded payloads.
+//
+// Received payloads are decoded in a single pass: the op code, sequence and
+// type are read directly into the Event, and only the event data is written
+// as JSON to Event.RawData, so the existing JSON struct tags are used for it.
+// Integers are converted to JSON numbers, except for the values of snowflake
+// keys ("id", "*_id" and "*_ids"), which are converted to JSON strings,
+// matching how Discord sends IDs in JSON payloads.
+type etfCodec struct{}
+
+func (etfCodec) Encoding() string {
+	return "etf"
+}
+
+func (etfCodec) Marshal(v interface{}) (int, []byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var t interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&t); err != nil {
+		return 0, nil, err
+	}
+
+	e := &etfEncoder{}
+	e.buf.WriteByte(etfVersion)
+	if err = e.term(t); err != nil {
+		return 0, nil, err
+	}
+
+	return websocket.BinaryMessage, e.buf.Bytes(), nil
+}
+
+func (etfCodec) Unmarshal(data []byte, e *Event) error {
+	if len(data) == 0 || data[0] != etfVersion {
+		return ErrETFInvalid
+	}
+
+	d := &etfDecoder{data: data, pos: 1}
+	return d.event(e)
+}
+
+// etfDecoder decodes ETF terms into their JSON representation.
+type etfDecoder struct {
+	data []byte
+	pos  int
+	buf  []byte
+
+	// Whether the current term is the value of a snowflake key, so that
+	// integers are written as strings.
+	snowflake bool
+}
+
+// read returns the next n bytes of the payload.
+func (d *etfDecoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, ErrETFInvalid
+	}
+
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *etfDecoder) uint8() (int, error) {
+	b, err := d.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return int(b[0]), nil
+}
+
+func (d *etfDecoder) uint16() (int, error) {
+	b, err := d.read(2)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(b)), nil
+}
+
+func (d *etfDecoder) uint32() (int, error) {
+	b, err := d.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint32(b)), nil
+}
+
+// event decodes the next term, which must be the map of a gateway payload,
+// into e. Only the data of the payload is converted to JSON.
+func (d *etfDecoder) event(e *Event) error {
+	tag, err := d.uint8()
+	if err != nil {
+		return err
+	}
+
+	if tag == etfCompressed {
+		inner, err := d.inflate()
+		if err != nil {
+			return err
+		}
+		return inner.event(e)
+	}
+	if tag != etfMap {
+		return fmt.Errorf("%w: payload is not a map", ErrETFInvalid)
+	}
+
+	n, err := d.uint32()
+	if err != nil {
+		return err
+	}
+
+	// JSON is usually a little larger than the ETF term it is decoded from.
+	d.buf = make([]byte, 0, len(d.data)*5/4)
+
+	*e = Event{}
+	for i := 0; i < n; i++ {
+		key, err := d.name()
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case "op":
+			var op int64
+			op, err = d.integer()
+			e.Operation = int(op)
+		case "s":
+			e.Sequence, err = d.integer()
+		case "t":
+			e.Type, err = d.name()
+		case "d":
+			start := len(d.buf)
+			if err = d.term(); err == nil {
+				e.RawData = json.RawMessage(d.buf[start:])
+			}
+		default:
+			// Unknown fields are decoded to validate the payload and
+			// discarded.
+			start := len(d.buf)
+			err = d.term()
+			d.buf = d.buf[:start]
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// name decodes the next term, which must be an atom, binary or nil, as a
+// string. nil is decoded as an empty string.
+func (d *etfDecoder) name() (string, error) {
+	tag, err := d.uint8()
+	if err != nil {
+		return "", err
+	}
+
+	var n int
+	switch tag {
+	case etfSmallAtom, etfSmallAtomUTF8:
+		n, err = d.uint8()
+	case etfAtom, etfAtomUTF8:
+		n, err = d.uint16()
+	case etfBinary:
+		n, err = d.uint32()
+	default:
+		return "", fmt.Errorf("%w: tag %d is not a name", ErrETFInvalid, tag)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	b, err := d.read(n)
+	if err != nil {
+		return "", err
+	}
+	if tag != etfBinary && string(b) == "nil" {
+		return "", nil
+	}
+	return string(b), nil
+}
+
+// integer decodes the next term, which must be an integer that fits in an
+// int64 or nil, as an int64. nil is decoded as 0.
+func (d *etfDecoder) integer() (int64, error) {
+	tag, err := d.uint8()
+	if err != nil {
+		return 0, err
+	}
+
+	switch tag {
+	case etfSmallInteger:
+		n, err := d.uint8()
+		return int64(n), err
+
+	case etfInteger:
+		n, err := d.uint32()
+		return int64(int32(n)), err
+
+	case etfSmallBig:
+		n, err := d.uint8()
+		if err != nil {
+			return 0, err
+		}
+		sign, v, err := d.bigUint64(n)
+		if err != nil || v > math.MaxInt64 {
+			return 0, ErrETFInvalid
+		}
+		if sign != 0 {
+			return -int64(v), nil
+		}
+		return int64(v), nil
+
+	case etfSmallAtom, etfSmallAtomUTF8:
+		d.pos--
+		if name, err := d.name(); err != nil || name != "" {
+			return 0, ErrETFInvalid
+		}
+		return 0, nil
+
+	default:
+		return 0, fmt.Errorf("%w: tag %d is not an integer", ErrETFInvalid, tag)
+	}
+}
+
+// inflate decodes a compressed term, returning a decoder for the
+// uncompressed term.
+func (d *etfDecoder) inflate() (*etfDecoder, error) {
+	size, err := d.uint32()
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(d.data[d.pos:])
+	z, err := zlib.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer z.Close()
+
+	inflated := make([]byte, size)
+	if _, err = io.ReadFull(z, inflated); err != nil {
+		return nil, err
+	}
+	d.pos = len(d.data) - r.Len()
+
+	return &etfDecoder{data: inflated}, nil
+}
+
+// term decodes the next term and writes it as JSON.
+func (d *etfDecoder) term() error {
+	tag, err := d.uint8()
+	if err != nil {
+		return err
+	}
+
+	switch tag {
+	case etfSmallInteger:
+		n, err := d.uint8()
+		if err != nil {
+			return err
+		}
+		var b [20]byte
+		d.appendInteger(strconv.AppendInt(b[:0], int64(n), 10))
+
+	case etfInteger:
+		n, err := d.uint32()
+		if err != nil {
+			return err
+		}
+		var b [20]byte
+		d.appendInteger(strconv.AppendInt(b[:0], int64(int32(n)), 10))
+
+	case etfNewFloat:
+		b, err := d.read(8)
+		if err != nil {
+			return err
+		}
+		d.writeFloat(math.Float64frombits(binary.BigEndian.Uint64(b)))
+
+	case etfFloat:
+		b, err := d.read(31)
+		if err != nil {
+			return err
+		}
+		f, err := strconv.ParseFloat(string(bytes.TrimRight(b, "\x00")), 64)
+		if err != nil {
+			return ErrETFInvalid
+		}
+		d.writeFloat(f)
+
+	case etfSmallBig, etfLargeBig:
+		var n int
+		if tag == etfSmallBig {
+			n, err = d.uint8()
+		} else {
+			n, err = d.uint32()
+		}
+		if err != nil {
+			return err
+		}
+		return d.big(n)
+
+	case etfAtom, etfAtomUTF8, etfSmallAtom, etfSmallAtomUTF8:
+		var n int
+		if tag == etfSmallAtom || tag == etfSmallAtomUTF8 {
+			n, err = d.uint8()
+		} else {
+			n, err = d.uint16()
+		}
+		if err != nil {
+			return err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return err
+		}
+
+		switch string(b) {
+		case "nil":
+			d.buf = append(d.buf, "null"...)
+		case "true", "false":
+			d.buf = append(d.buf, b...)
+		default:
+			d.buf = appendJSONString(d.buf, b)
+		}
+
+	case etfBinary:
+		n, err := d.uint32()
+		if err != nil {
+			return err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return err
+		}
+		d.buf = appendJSONString(d.buf, b)
+
+	case etfNil:
+		d.buf = append(d.buf, "[]"...)
+
+	case etfString:
+		// A string is a list of small integers.
+		n, err := d.uint16()
+		if err != nil {
+			return err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return err
+		}
+		d.buf = append(d.buf, '[')
+		for i, c := range b {
+			if i > 0 {
+				d.buf = append(d.buf, ',')
+			}
+			d.buf = strconv.AppendInt(d.buf, int64(c), 10)
+		}
+		d.buf = append(d.buf, ']')
+
+	case etfList:
+		n, err := d.uint32()
+		if err != nil {
+			return err
+		}
+		d.buf = append(d.buf, '[')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				d.buf = append(d.buf, ',')
+			}
+			if err = d.term(); err != nil {
+				return err
+			}
+		}
+
+		// Proper lists end with an empty list tail, anything else is kept
+		// as the last element.
+		if d.pos < len(d.data) && d.data[d.pos] == etfNil {
+			d.pos++
+		} else {
+			if n > 0 {
+				d.buf = append(d.buf, ',')
+			}
+			if err = d.term(); err != nil {
+				return err
+			}
+		}
+		d.buf = append(d.buf, ']')
+
+	case etfSmallTuple, etfLargeTuple:
+		var n int
+		if tag == etfSmallTuple {
+			n, err = d.uint8()
+		} else {
+			n, err = d.uint32()
+		}
+		if err != nil {
+			return err
+		}
+		d.buf = append(d.buf, '[')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				d.buf = append(d.buf, ',')
+			}
+			if err = d.term(); err != nil {
+				return err
+			}
+		}
+		d.buf = append(d.buf, ']')
+
+	case etfMap:
+		n, err := d.uint32()
+		if err != nil {
+			return err
+		}
+		parent := d.snowflake
+		d.buf = append(d.buf, '{')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				d.buf = append(d.buf, ',')
+			}
+			start := len(d.buf)
+			if err = d.key(); err != nil {
+				return err
+			}
+			d.snowflake = isSnowflakeKey(d.buf[start:])
+			d.buf = append(d.buf, ':')
+			if err = d.term(); err != nil {
+				return err
+			}
+		}
+		d.buf = append(d.buf, '}')
+		d.snowflake = parent
+
+	case etfCompressed:
+		inner, err := d.inflate()
+		if err != nil {
+			return err
+		}
+		inner.snowflake = d.snowflake
+		if err = inner.term(); err != nil {
+			return err
+		}
+		d.buf = append(d.buf, inner.buf...)
+
+	default:
+		return fmt.Errorf("%w: unsupported tag %d", ErrETFInvalid, tag)
+	}
+
+	return nil
+}
+
+// key decodes the next term as a JSON object key. Keys which aren't
+// strings in JSON, such as integers, are quoted.
+func (d *etfDecoder) key() error {
+	start := len(d.buf)
+	if err := d.term(); err != nil {
+		return err
+	}
+
+	if k := d.buf[start:]; len(k) == 0 || k[0] != '"' {
+		s := string(k)
+		d.buf = d.buf[:start]
+		d.buf = appendJSONString(d.buf, []byte(s))
+	}
+
+	return nil
+}
+
+// bigUint64 decodes the sign and absolute value of a big integer with n
+// digits, which must fit in a uint64.
+func (d *etfDecoder) bigUint64(n int) (sign int, v uint64, err error) {
+	if n > 8 {
+		return 0, 0, ErrETFInvalid
+	}
+
+	sign, err = d.uint8()
+	if err != nil {
+		return
+	}
+	digits, err := d.read(n)
+	if err != nil {
+		return
+	}
+
+	// Digits are stored little endian.
+	for i := n - 1; i >= 0; i-- {
+		v = v<<8 | uint64(digits[i])
+	}
+	return
+}
+
+// isSnowflakeKey returns whether a quoted JSON object key holds snowflake
+// IDs.
+func isSnowflakeKey(key []byte) bool {
+	key = bytes.Trim(key, `"`)
+	return string(key) == "id" || bytes.HasSuffix(key, []byte("_id")) || bytes.HasSuffix(key, []byte("_ids"))
+}
+
+// appendInteger writes the decimal digits of an integer, as a string when
+// the integer is a snowflake.
+func (d *etfDecoder) appendInteger(digits []byte) {
+	if d.snowflake {
+		d.buf = appendJSONString(d.buf, digits)
+	} else {
+		d.buf = append(d.buf, digits...)
+	}
+}
+
+// big decodes a big integer with n digits.
+func (d *etfDecoder) big(n int) error {
+	if n <= 8 {
+		sign, v, err := d.bigUint64(n)
+		if err != nil {
+			return err
+		}
+
+		var b [21]byte
+		s := b[:0]
+		if sign != 0 {
+			s = append(s, '-')
+		}
+		d.appendInteger(strconv.AppendUint(s, v, 10))
+		return nil
+	}
+
+	sign, err := d.uint8()
+	if err != nil {
+		return err
+	}
+	digits, err := d.read(n)
+	if err != nil {
+		return err
+	}
+
+	be := make([]byte, n)
+	for i, c := range digits {
+		be[n-1-i] = c
+	}
+
+	v := new(big.Int).SetBytes(be)
+	if sign != 0 {
+		v.Neg(v)
+	}
+	d.appendInteger([]byte(v.String()))
+	return nil
+}
+
+func (d *etfDecoder) writeFloat(f float64) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		d.buf = append(d.buf, "null"...)
+		return
+	}
+	d.buf = strconv.AppendFloat(d.buf, f, 'g', -1, 64)
+}
+
+// jsonEscaped reports which bytes must be escaped in JSON strings.
+var jsonEscaped = func() (t [256]bool) {
+	for c := 0; c < 0x20; c++ {
+		t[c] = true
+	}
+	t['"'] = true
+	t['\\'] = true
+	return
+}()
+
+// appendJSONString appends s as a quoted JSON string to dst and returns the
+// extended buffer.
+func appendJSONString(dst []byte, s []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	start := 0
+	for i, c := range s {
+		if !jsonEscaped[c] {
+			continue
+		}
+
+		// Bytes which don't need escaping are appended in runs.
+		dst = append(dst, s[start:i]...)
+		start = i + 1
+
+		if c < 0x20 {
+			dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		} else {
+			dst = append(dst, '\\', c)
+		}
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// etfEncoder encodes values decoded from JSON as ETF terms.
+type etfEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *etfEncoder) uint16(n int) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(n))
+	e.buf.Write(b[:])
+}
+
+func (e *etfEncoder) uint32(n int) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(n))
+	e.buf.Write(b[:])
+}
+
+func (e *etfEncoder) atom(name string) {
+	e.buf.WriteByte(etfSmallAtomUTF8)
+	e.buf.WriteByte(byte(len(name)))
+	e.buf.WriteString(name)
+}
+
+func (e *etfEncoder) binary(s string) {
+	e.buf.WriteByte(etfBinary)
+	e.uint32(len(s))
+	e.buf.WriteString(s)
+}
+
+// term encodes v, which must be a value decoded from JSON with UseNumber.
+func (e *etfEncoder) term(v interface{}) error {
+	switch t := v.(type) {
+	case nil:
+		e.atom("nil")
+
+	case bool:
+		if t {
+			e.atom("true")
+		} else {
+			e.atom("false")
+		}
+
+	case string:
+		e.binary(t)
+
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			e.integer(i)
+			return nil
+		}
+
+		f, err := t.Float64()
+		if err != nil {
+			return err
+		}
+		e.buf.WriteByte(etfNewFloat)
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], math.Float64bits(f))
+		e.buf.Write(b[:])
+
+	case []interface{}:
+		if len(t) == 0 {
+			e.buf.WriteByte(etfNil)
+			return nil
+		}
+
+		e.buf.WriteByte(etfList)
+		e.uint32(len(t))
+		for _, el := range t {
+			if err := e.term(el); err != nil {
+				return err
+			}
+		}
+		e.buf.WriteByte(etfNil)
+
+	case map[string]interface{}:
+		e.buf.WriteByte(etfMap)
+		e.uint32(len(t))
+		for k, el := range t {
+			e.binary(k)
+			if err := e.term(el); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return fmt.Errorf("etf: unsupported type %T", v)
+	}
+
+	return nil
+}
+
+// integer encodes i with the smallest integer term that fits it.
+func (e *etfEncoder) integer(i int64) {
+	switch {
+	case i >= 0 && i <= etfMaxSmallIntegerN:
+		e.buf.WriteByte(etfSmallInteger)
+		e.buf.WriteByte(byte(i))
+
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		e.buf.WriteByte(etfInteger)
+		e.uint32(int(uint32(int32(i))))
+
+	default:
+		sign := byte(0)
+		u := uint64(i)
+		if i < 0 {
+			sign = 1
+			u = uint64(-i)
+		}
+
+		var digits []byte
+		for u > 0 {
+			digits = append(digits, byte(u))
+			u >>= 8
+		}
+
+		e.buf.WriteByte(etfSmallBig)
+		e.buf.WriteByte(byte(len(digits)))
+		e.buf.WriteByte(sign)
+		e.buf.Write(digits)
+	}
+}
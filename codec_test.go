@@ -0,0 +1,231 @@
+package astatine
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestETFCodecUnmarshal(t *testing.T) {
+	// {op: 0, s: 2, t: :MESSAGE_CREATE, d: %{id: 844889289376546826,
+	// nonce: nil, pinned: false, embeds: [], timestamp: 1633024800000,
+	// content: "hi\"\n"}}
+	payload := []byte{
+		etfVersion, etfMap, 0, 0, 0, 4,
+		etfSmallAtomUTF8, 2, 'o', 'p', etfSmallInteger, 0,
+		etfSmallAtomUTF8, 1, 's', etfSmallInteger, 2,
+		etfSmallAtomUTF8, 1, 't', etfAtomUTF8, 0, 14, 'M', 'E', 'S', 'S', 'A', 'G', 'E', '_', 'C', 'R', 'E', 'A', 'T', 'E',
+		etfSmallAtomUTF8, 1, 'd', etfMap, 0, 0, 0, 6,
+		etfBinary, 0, 0, 0, 2, 'i', 'd', etfSmallBig, 8, 0, 0x0a, 0x40, 0xd2, 0x0f, 0x55, 0xa6, 0xb9, 0x0b,
+		etfBinary, 0, 0, 0, 5, 'n', 'o', 'n', 'c', 'e', etfSmallAtomUTF8, 3, 'n', 'i', 'l',
+		etfBinary, 0, 0, 0, 6, 'p', 'i', 'n', 'n', 'e', 'd', etfSmallAtomUTF8, 5, 'f', 'a', 'l', 's', 'e',
+		etfBinary, 0, 0, 0, 6, 'e', 'm', 'b', 'e', 'd', 's', etfNil,
+		etfBinary, 0, 0, 0, 9, 't', 'i', 'm', 'e', 's', 't', 'a', 'm', 'p', etfSmallBig, 6, 0, 0x00, 0xf5, 0xdc, 0x37, 0x7c, 0x01,
+		etfBinary, 0, 0, 0, 7, 'c', 'o', 'n', 't', 'e', 'n', 't', etfBinary, 0, 0, 0, 4, 'h', 'i', '"', '\n',
+	}
+
+	var e Event
+	if err := GatewayCodecETF.Unmarshal(payload, &e); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if e.Operation != 0 || e.Sequence != 2 || e.Type != "MESSAGE_CREATE" {
+		t.Errorf("Unmarshal decoded op %d, s %d, t %q", e.Operation, e.Sequence, e.Type)
+	}
+
+	var d map[string]interface{}
+	if err := json.Unmarshal(e.RawData, &d); err != nil {
+		t.Fatalf("RawData is not valid JSON: %v, %s", err, e.RawData)
+	}
+
+	want := `{"content":"hi\"\n","embeds":[],"id":"844889289376546826","nonce":null,"pinned":false,"timestamp":1633024800000}`
+	if got, _ := json.Marshal(d); string(got) != want {
+		t.Errorf("RawData decoded to %s, want %s", got, want)
+	}
+}
+
+func TestETFCodecCompressed(t *testing.T) {
+	term := []byte{etfSmallTuple, 2, etfSmallInteger, 1, etfInteger, 0xff, 0xff, 0xff, 0xfe}
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	w.Write(term)
+	w.Close()
+
+	payload := []byte{etfVersion, etfMap, 0, 0, 0, 1, etfSmallAtomUTF8, 1, 'd', etfCompressed, 0, 0, 0, byte(len(term))}
+	payload = append(payload, compressed.Bytes()...)
+
+	var e Event
+	if err := GatewayCodecETF.Unmarshal(payload, &e); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if string(e.RawData) != "[1,-2]" {
+		t.Errorf("RawData is %s, want [1,-2]", e.RawData)
+	}
+}
+
+func TestETFCodecRoundTrip(t *testing.T) {
+	op := requestGuildMembersOp{8, requestGuildMembersData{
+		GuildIDs: []string{"844889289376546826"},
+		Query:    "ab",
+		Limit:    1000,
+	}}
+
+	mt, data, err := GatewayCodecETF.Marshal(op)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if mt != websocket.BinaryMessage {
+		t.Errorf("Marshal returned message type %d, want %d", mt, websocket.BinaryMessage)
+	}
+
+	var e Event
+	if err = GatewayCodecETF.Unmarshal(data, &e); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if e.Operation != 8 {
+		t.Errorf("Unmarshal decoded op %d, want 8", e.Operation)
+	}
+
+	var got requestGuildMembersData
+	if err = json.Unmarshal(e.RawData, &got); err != nil {
+		t.Fatalf("RawData is not valid JSON: %v, %s", err, e.RawData)
+	}
+	if len(got.GuildIDs) != 1 || got.GuildIDs[0] != op.Data.GuildIDs[0] || got.Query != op.Data.Query || got.Limit != op.Data.Limit {
+		t.Errorf("round trip returned %+v, want %+v", got, op.Data)
+	}
+}
+
+func TestETFCodecActivityTimestamps(t *testing.T) {
+	// %{created_at: 1633024800000, timestamps: %{start: 1633024800000}}
+	payload := []byte{
+		etfVersion, etfMap, 0, 0, 0, 1,
+		etfSmallAtomUTF8, 1, 'd', etfMap, 0, 0, 0, 2,
+		etfBinary, 0, 0, 0, 10, 'c', 'r', 'e', 'a', 't', 'e', 'd', '_', 'a', 't', etfSmallBig, 6, 0, 0x00, 0xf5, 0xdc, 0x37, 0x7c, 0x01,
+		etfBinary, 0, 0, 0, 10, 't', 'i', 'm', 'e', 's', 't', 'a', 'm', 'p', 's', etfMap, 0, 0, 0, 1,
+		etfBinary, 0, 0, 0, 5, 's', 't', 'a', 'r', 't', etfSmallBig, 6, 0, 0x00, 0xf5, 0xdc, 0x37, 0x7c, 0x01,
+	}
+
+	var e Event
+	if err := GatewayCodecETF.Unmarshal(payload, &e); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	var a Activity
+	if err := json.Unmarshal(e.RawData, &a); err != nil {
+		t.Fatalf("Activity failed to unmarshal: %v, %s", err, e.RawData)
+	}
+	if a.CreatedAt.UnixNano()/1e6 != 1633024800000 || a.Timestamps.StartTimestamp != 1633024800000 {
+		t.Errorf("Activity decoded created at %d, start %d, want 1633024800000", a.CreatedAt.UnixNano()/1e6, a.Timestamps.StartTimestamp)
+	}
+}
+
+func TestETFCodecLargeIntegers(t *testing.T) {
+	// %{user: %{id: 844889289376546826, public_flags: 4194304,
+	// flags: 17592186044416}, roles: [844889289376546826, 1],
+	// since: 1633024800000}, as in PRESENCE_UPDATE.
+	payload := []byte{
+		etfVersion, etfMap, 0, 0, 0, 1,
+		etfSmallAtomUTF8, 1, 'd', etfMap, 0, 0, 0, 3,
+		etfBinary, 0, 0, 0, 4, 'u', 's', 'e', 'r', etfMap, 0, 0, 0, 3,
+		etfBinary, 0, 0, 0, 2, 'i', 'd', etfSmallBig, 8, 0, 0x0a, 0x40, 0xd2, 0x0f, 0x55, 0xa6, 0xb9, 0x0b,
+		etfBinary, 0, 0, 0, 12, 'p', 'u', 'b', 'l', 'i', 'c', '_', 'f', 'l', 'a', 'g', 's', etfInteger, 0, 0x40, 0, 0,
+		etfBinary, 0, 0, 0, 5, 'f', 'l', 'a', 'g', 's', etfSmallBig, 6, 0, 0, 0, 0, 0, 0, 0x10,
+		etfBinary, 0, 0, 0, 8, 'r', 'o', 'l', 'e', '_', 'i', 'd', 's', etfList, 0, 0, 0, 2,
+		etfSmallBig, 8, 0, 0x0a, 0x40, 0xd2, 0x0f, 0x55, 0xa6, 0xb9, 0x0b, etfSmallInteger, 1, etfNil,
+		etfBinary, 0, 0, 0, 5, 's', 'i', 'n', 'c', 'e', etfSmallBig, 6, 0, 0x00, 0xf5, 0xdc, 0x37, 0x7c, 0x01,
+	}
+
+	var e Event
+	if err := GatewayCodecETF.Unmarshal(payload, &e); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	var p struct {
+		Presence
+		User struct {
+			ID          string `json:"id"`
+			PublicFlags int64  `json:"public_flags"`
+			Flags       int64  `json:"flags"`
+		} `json:"user"`
+		RoleIDs []string `json:"role_ids"`
+	}
+	if err := json.Unmarshal(e.RawData, &p); err != nil {
+		t.Fatalf("presence failed to unmarshal: %v, %s", err, e.RawData)
+	}
+
+	if p.Since == nil || *p.Since != 1633024800000 {
+		t.Errorf("since is %v, want 1633024800000", p.Since)
+	}
+	if p.User.ID != "844889289376546826" || p.User.PublicFlags != 1<<22 || p.User.Flags != 1<<44 {
+		t.Errorf("user decoded to %+v", p.User)
+	}
+	if len(p.RoleIDs) != 2 || p.RoleIDs[0] != "844889289376546826" || p.RoleIDs[1] != "1" {
+		t.Errorf("role IDs decoded to %v", p.RoleIDs)
+	}
+}
+
+// guildCreatePayload returns a GUILD_CREATE payload of a large guild,
+// encoded with codec.
+func guildCreatePayload(b *testing.B, codec GatewayCodec) []byte {
+	g := &Guild{
+		ID:          "844889289376546826",
+		Name:        "benchmark",
+		MemberCount: 1000,
+	}
+	for i := 0; i < 100; i++ {
+		g.Roles = append(g.Roles, &Role{ID: strconv.Itoa(844889289376546826 + i), Name: "role", Permissions: 1071698660929})
+	}
+	for i := 0; i < 200; i++ {
+		g.Channels = append(g.Channels, &Channel{ID: strconv.Itoa(844889289376546826 + i), GuildID: g.ID, Name: "channel", Topic: "a channel topic"})
+	}
+	for i := 0; i < 1000; i++ {
+		id := strconv.Itoa(744889289376546826 + i)
+		g.Members = append(g.Members, &Member{
+			GuildID:  g.ID,
+			JoinedAt: time.Unix(1633024800, 0),
+			User:     &User{ID: id, Username: "user", Discriminator: "0001", Avatar: "a_0123456789abcdef0123456789abcdef"},
+			Roles:    []string{g.Roles[i%len(g.Roles)].ID},
+		})
+		g.Presences = append(g.Presences, &Presence{User: &User{ID: id}, Status: StatusOnline})
+	}
+
+	_, data, err := codec.Marshal(struct {
+		Operation int    `json:"op"`
+		Sequence  int64  `json:"s"`
+		Type      string `json:"t"`
+		Data      *Guild `json:"d"`
+	}{0, 2, "GUILD_CREATE", g})
+	if err != nil {
+		b.Fatalf("Marshal returned error: %v", err)
+	}
+	return data
+}
+
+func BenchmarkGatewayCodecGuildCreate(b *testing.B) {
+	for _, codec := range []GatewayCodec{GatewayCodecJSON, GatewayCodecETF} {
+		b.Run(codec.Encoding(), func(b *testing.B) {
+			data := guildCreatePayload(b, codec)
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				var e Event
+				if err := codec.Unmarshal(data, &e); err != nil {
+					b.Fatalf("Unmarshal returned error: %v", err)
+				}
+
+				var g GuildCreate
+				if err := json.Unmarshal(e.RawData, &g); err != nil {
+					b.Fatalf("GuildCreate failed to unmarshal: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@ package astatine
 
 import (
 	netHttp "net/http"
-	"runtime"
 	"time"
 
 	"github.com/ayntgl/astatine/http"
@@ -41,6 +40,7 @@ func New(token string) (s *Session) {
 		ShardID:                0,
 		ShardCount:             1,
 		MaxRestRetries:         3,
+		RetryPolicy:            DefaultRetryPolicy.clone(),
 		Client:                 &netHttp.Client{Timeout: (20 * time.Second)},
 		UserAgent:              "DiscordBot (https://github.com/bwmarrin/discordgo, v" + VERSION + ")",
 		sequence:               new(int64),
@@ -51,8 +51,7 @@ func New(token string) (s *Session) {
 	// These can be modified prior to calling Open()
 	s.Identify.Compress = true
 	s.Identify.LargeThreshold = 250
-	s.Identify.Properties.OS = runtime.GOOS
-	s.Identify.Properties.Browser = "DiscordGo v" + VERSION
+	s.Identify.Properties = IdentifyPresetBot.Properties
 	s.Identify.Intents = IntentsAllWithoutPrivileged
 	s.Identify.Token = token
 
@@ -112,7 +112,7 @@ func (s *Session) addEventHandlerOnce(eventHandler EventHandler) func() {
 // List of events can be found at this page, with corresponding names in the
 // library for each event: https://discord.com/developers/docs/topics/gateway#event-names
 // There are also synthetic events fired by the library internally which are
-// available for handling, like Connect, Disconnect, and RateLimit.
+// available for handling, like Connect, Disconnect, RateLimit and RequestRetry.
 // events.go contains all of the Discord WSAPI and synthetic events that can be handled.
 //
 // The return value of this method is a function, that when called will remove the
@@ -244,4 +244,5 @@ func (s *Session) onReady(r *Ready) {
 
 	// Store the SessionID within the Session struct.
 	s.sessionID = r.SessionID
+	s.resumeGateway = r.ResumeGatewayURL
 }
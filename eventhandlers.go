@@ -7,64 +7,154 @@ package astatine
 // Event type values are used to match the events returned by Discord.
 // EventTypes surrounded by __ are synthetic and are internal to DiscordGo.
 const (
-	channelCreateEventType             = "CHANNEL_CREATE"
-	channelDeleteEventType             = "CHANNEL_DELETE"
-	channelPinsUpdateEventType         = "CHANNEL_PINS_UPDATE"
-	channelUpdateEventType             = "CHANNEL_UPDATE"
-	connectEventType                   = "__CONNECT__"
-	disconnectEventType                = "__DISCONNECT__"
-	eventEventType                     = "__EVENT__"
-	guildBanAddEventType               = "GUILD_BAN_ADD"
-	guildBanRemoveEventType            = "GUILD_BAN_REMOVE"
-	guildCreateEventType               = "GUILD_CREATE"
-	guildDeleteEventType               = "GUILD_DELETE"
-	guildEmojisUpdateEventType         = "GUILD_EMOJIS_UPDATE"
-	guildIntegrationsUpdateEventType   = "GUILD_INTEGRATIONS_UPDATE"
-	guildMemberAddEventType            = "GUILD_MEMBER_ADD"
-	guildMemberRemoveEventType         = "GUILD_MEMBER_REMOVE"
-	guildMemberUpdateEventType         = "GUILD_MEMBER_UPDATE"
-	guildMembersChunkEventType         = "GUILD_MEMBERS_CHUNK"
-	guildRoleCreateEventType           = "GUILD_ROLE_CREATE"
-	guildRoleDeleteEventType           = "GUILD_ROLE_DELETE"
-	guildRoleUpdateEventType           = "GUILD_ROLE_UPDATE"
-	guildUpdateEventType               = "GUILD_UPDATE"
-	guildScheduledEventCreateEventType = "GUILD_SCHEDULED_EVENT_CREATE"
-	guildScheduledEventUpdateEventType = "GUILD_SCHEDULED_EVENT_UPDATE"
-	guildScheduledEventDeleteEventType = "GUILD_SCHEDULED_EVENT_DELETE"
-	interactionCreateEventType         = "INTERACTION_CREATE"
-	inviteCreateEventType              = "INVITE_CREATE"
-	inviteDeleteEventType              = "INVITE_DELETE"
-	messageAckEventType                = "MESSAGE_ACK"
-	messageCreateEventType             = "MESSAGE_CREATE"
-	messageDeleteEventType             = "MESSAGE_DELETE"
-	messageDeleteBulkEventType         = "MESSAGE_DELETE_BULK"
-	messageReactionAddEventType        = "MESSAGE_REACTION_ADD"
-	messageReactionRemoveEventType     = "MESSAGE_REACTION_REMOVE"
-	messageReactionRemoveAllEventType  = "MESSAGE_REACTION_REMOVE_ALL"
-	messageUpdateEventType             = "MESSAGE_UPDATE"
-	presenceUpdateEventType            = "PRESENCE_UPDATE"
-	presencesReplaceEventType          = "PRESENCES_REPLACE"
-	rateLimitEventType                 = "__RATE_LIMIT__"
-	readyEventType                     = "READY"
-	relationshipAddEventType           = "RELATIONSHIP_ADD"
-	relationshipRemoveEventType        = "RELATIONSHIP_REMOVE"
-	resumedEventType                   = "RESUMED"
-	threadCreateEventType              = "THREAD_CREATE"
-	threadDeleteEventType              = "THREAD_DELETE"
-	threadListSyncEventType            = "THREAD_LIST_SYNC"
-	threadMemberUpdateEventType        = "THREAD_MEMBER_UPDATE"
-	threadMembersUpdateEventType       = "THREAD_MEMBERS_UPDATE"
-	threadUpdateEventType              = "THREAD_UPDATE"
-	typingStartEventType               = "TYPING_START"
-	userGuildSettingsUpdateEventType   = "USER_GUILD_SETTINGS_UPDATE"
-	userNoteUpdateEventType            = "USER_NOTE_UPDATE"
-	userSettingsUpdateEventType        = "USER_SETTINGS_UPDATE"
-	userUpdateEventType                = "USER_UPDATE"
-	voiceServerUpdateEventType         = "VOICE_SERVER_UPDATE"
-	voiceStateUpdateEventType          = "VOICE_STATE_UPDATE"
-	webhooksUpdateEventType            = "WEBHOOKS_UPDATE"
+	autoModerationActionExecutionEventType = "AUTO_MODERATION_ACTION_EXECUTION"
+	autoModerationRuleCreateEventType      = "AUTO_MODERATION_RULE_CREATE"
+	autoModerationRuleDeleteEventType      = "AUTO_MODERATION_RULE_DELETE"
+	autoModerationRuleUpdateEventType      = "AUTO_MODERATION_RULE_UPDATE"
+	channelCreateEventType                 = "CHANNEL_CREATE"
+	channelDeleteEventType                 = "CHANNEL_DELETE"
+	channelPinsUpdateEventType             = "CHANNEL_PINS_UPDATE"
+	channelUpdateEventType                 = "CHANNEL_UPDATE"
+	connectEventType                       = "__CONNECT__"
+	disconnectEventType                    = "__DISCONNECT__"
+	eventEventType                         = "__EVENT__"
+	guildBanAddEventType                   = "GUILD_BAN_ADD"
+	guildBanRemoveEventType                = "GUILD_BAN_REMOVE"
+	guildCreateEventType                   = "GUILD_CREATE"
+	guildDeleteEventType                   = "GUILD_DELETE"
+	guildEmojisUpdateEventType             = "GUILD_EMOJIS_UPDATE"
+	guildIntegrationsUpdateEventType       = "GUILD_INTEGRATIONS_UPDATE"
+	guildMemberAddEventType                = "GUILD_MEMBER_ADD"
+	guildMemberListUpdateEventType         = "GUILD_MEMBER_LIST_UPDATE"
+	guildMemberRemoveEventType             = "GUILD_MEMBER_REMOVE"
+	guildMemberUpdateEventType             = "GUILD_MEMBER_UPDATE"
+	guildMembersChunkEventType             = "GUILD_MEMBERS_CHUNK"
+	guildRoleCreateEventType               = "GUILD_ROLE_CREATE"
+	guildRoleDeleteEventType               = "GUILD_ROLE_DELETE"
+	guildRoleUpdateEventType               = "GUILD_ROLE_UPDATE"
+	guildScheduledEventCreateEventType     = "GUILD_SCHEDULED_EVENT_CREATE"
+	guildScheduledEventDeleteEventType     = "GUILD_SCHEDULED_EVENT_DELETE"
+	guildScheduledEventUpdateEventType     = "GUILD_SCHEDULED_EVENT_UPDATE"
+	guildStickersUpdateEventType           = "GUILD_STICKERS_UPDATE"
+	guildUpdateEventType                   = "GUILD_UPDATE"
+	interactionCreateEventType             = "INTERACTION_CREATE"
+	inviteCreateEventType                  = "INVITE_CREATE"
+	inviteDeleteEventType                  = "INVITE_DELETE"
+	messageAckEventType                    = "MESSAGE_ACK"
+	messageCreateEventType                 = "MESSAGE_CREATE"
+	messageDeleteEventType                 = "MESSAGE_DELETE"
+	messageDeleteBulkEventType             = "MESSAGE_DELETE_BULK"
+	messageReactionAddEventType            = "MESSAGE_REACTION_ADD"
+	messageReactionRemoveEventType         = "MESSAGE_REACTION_REMOVE"
+	messageReactionRemoveAllEventType      = "MESSAGE_REACTION_REMOVE_ALL"
+	messageUpdateEventType                 = "MESSAGE_UPDATE"
+	presenceUpdateEventType                = "PRESENCE_UPDATE"
+	presencesReplaceEventType              = "PRESENCES_REPLACE"
+	rateLimitEventType                     = "__RATE_LIMIT__"
+	readyEventType                         = "READY"
+	relationshipAddEventType               = "RELATIONSHIP_ADD"
+	relationshipRemoveEventType            = "RELATIONSHIP_REMOVE"
+	requestRetryEventType                  = "__REQUEST_RETRY__"
+	resumedEventType                       = "RESUMED"
+	stageInstanceCreateEventType           = "STAGE_INSTANCE_CREATE"
+	stageInstanceDeleteEventType           = "STAGE_INSTANCE_DELETE"
+	stageInstanceUpdateEventType           = "STAGE_INSTANCE_UPDATE"
+	threadCreateEventType                  = "THREAD_CREATE"
+	threadDeleteEventType                  = "THREAD_DELETE"
+	threadListSyncEventType                = "THREAD_LIST_SYNC"
+	threadMemberUpdateEventType            = "THREAD_MEMBER_UPDATE"
+	threadMembersUpdateEventType           = "THREAD_MEMBERS_UPDATE"
+	threadUpdateEventType                  = "THREAD_UPDATE"
+	typingStartEventType                   = "TYPING_START"
+	userGuildSettingsUpdateEventType       = "USER_GUILD_SETTINGS_UPDATE"
+	userNoteUpdateEventType                = "USER_NOTE_UPDATE"
+	userSettingsUpdateEventType            = "USER_SETTINGS_UPDATE"
+	userUpdateEventType                    = "USER_UPDATE"
+	voiceServerUpdateEventType             = "VOICE_SERVER_UPDATE"
+	voiceStateUpdateEventType              = "VOICE_STATE_UPDATE"
+	webhooksUpdateEventType                = "WEBHOOKS_UPDATE"
 )
 
+// autoModerationActionExecutionEventHandler is an event handler for AutoModerationActionExecution events.
+type autoModerationActionExecutionEventHandler func(*Session, *AutoModerationActionExecution)
+
+// Type returns the event type for AutoModerationActionExecution events.
+func (eh autoModerationActionExecutionEventHandler) Type() string {
+	return autoModerationActionExecutionEventType
+}
+
+// New returns a new instance of AutoModerationActionExecution.
+func (eh autoModerationActionExecutionEventHandler) New() interface{} {
+	return &AutoModerationActionExecution{}
+}
+
+// Handle is the handler for AutoModerationActionExecution events.
+func (eh autoModerationActionExecutionEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AutoModerationActionExecution); ok {
+		eh(s, t)
+	}
+}
+
+// autoModerationRuleCreateEventHandler is an event handler for AutoModerationRuleCreate events.
+type autoModerationRuleCreateEventHandler func(*Session, *AutoModerationRuleCreate)
+
+// Type returns the event type for AutoModerationRuleCreate events.
+func (eh autoModerationRuleCreateEventHandler) Type() string {
+	return autoModerationRuleCreateEventType
+}
+
+// New returns a new instance of AutoModerationRuleCreate.
+func (eh autoModerationRuleCreateEventHandler) New() interface{} {
+	return &AutoModerationRuleCreate{}
+}
+
+// Handle is the handler for AutoModerationRuleCreate events.
+func (eh autoModerationRuleCreateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AutoModerationRuleCreate); ok {
+		eh(s, t)
+	}
+}
+
+// autoModerationRuleDeleteEventHandler is an event handler for AutoModerationRuleDelete events.
+type autoModerationRuleDeleteEventHandler func(*Session, *AutoModerationRuleDelete)
+
+// Type returns the event type for AutoModerationRuleDelete events.
+func (eh autoModerationRuleDeleteEventHandler) Type() string {
+	return autoModerationRuleDeleteEventType
+}
+
+// New returns a new instance of AutoModerationRuleDelete.
+func (eh autoModerationRuleDeleteEventHandler) New() interface{} {
+	return &AutoModerationRuleDelete{}
+}
+
+// Handle is the handler for AutoModerationRuleDelete events.
+func (eh autoModerationRuleDeleteEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AutoModerationRuleDelete); ok {
+		eh(s, t)
+	}
+}
+
+// autoModerationRuleUpdateEventHandler is an event handler for AutoModerationRuleUpdate events.
+type autoModerationRuleUpdateEventHandler func(*Session, *AutoModerationRuleUpdate)
+
+// Type returns the event type for AutoModerationRuleUpdate events.
+func (eh autoModerationRuleUpdateEventHandler) Type() string {
+	return autoModerationRuleUpdateEventType
+}
+
+// New returns a new instance of AutoModerationRuleUpdate.
+func (eh autoModerationRuleUpdateEventHandler) New() interface{} {
+	return &AutoModerationRuleUpdate{}
+}
+
+// Handle is the handler for AutoModerationRuleUpdate events.
+func (eh autoModerationRuleUpdateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AutoModerationRuleUpdate); ok {
+		eh(s, t)
+	}
+}
+
 // channelCreateEventHandler is an event handler for ChannelCreate events.
 type channelCreateEventHandler func(*Session, *ChannelCreate)
 
@@ -310,66 +400,6 @@ func (eh guildIntegrationsUpdateEventHandler) Handle(s *Session, i interface{})
 	}
 }
 
-// guildScheduledEventCreateEventHandler is an event handler for GuildScheduledEventCreate events.
-type guildScheduledEventCreateEventHandler func(*Session, *GuildScheduledEventCreate)
-
-// Type returns the event type for GuildScheduledEventCreate events.
-func (eh guildScheduledEventCreateEventHandler) Type() string {
-	return guildScheduledEventCreateEventType
-}
-
-// New returns a new instance of GuildScheduledEventCreate.
-func (eh guildScheduledEventCreateEventHandler) New() interface{} {
-	return &GuildScheduledEventCreate{}
-}
-
-// Handle is the handler for GuildScheduledEventCreate events.
-func (eh guildScheduledEventCreateEventHandler) Handle(s *Session, i interface{}) {
-	if t, ok := i.(*GuildScheduledEventCreate); ok {
-		eh(s, t)
-	}
-}
-
-// guildScheduledEventUpdateEventHandler is an event handler for GuildScheduledEventUpdate events.
-type guildScheduledEventUpdateEventHandler func(*Session, *GuildScheduledEventUpdate)
-
-// Type returns the event type for GuildScheduledEventUpdate events.
-func (eh guildScheduledEventUpdateEventHandler) Type() string {
-	return guildScheduledEventUpdateEventType
-}
-
-// New returns a new instance of GuildScheduledEventUpdate.
-func (eh guildScheduledEventUpdateEventHandler) New() interface{} {
-	return &GuildScheduledEventUpdate{}
-}
-
-// Handle is the handler for GuildScheduledEventUpdate events.
-func (eh guildScheduledEventUpdateEventHandler) Handle(s *Session, i interface{}) {
-	if t, ok := i.(*GuildScheduledEventUpdate); ok {
-		eh(s, t)
-	}
-}
-
-// guildScheduledEventDeleteEventHandler is an event handler for GuildScheduledEventDelete events.
-type guildScheduledEventDeleteEventHandler func(*Session, *GuildScheduledEventDelete)
-
-// Type returns the event type for GuildScheduledEventDelete events.
-func (eh guildScheduledEventDeleteEventHandler) Type() string {
-	return guildScheduledEventDeleteEventType
-}
-
-// New returns a new instance of GuildScheduledEventDelete.
-func (eh guildScheduledEventDeleteEventHandler) New() interface{} {
-	return &GuildScheduledEventDelete{}
-}
-
-// Handle is the handler for GuildScheduledEventDelete events.
-func (eh guildScheduledEventDeleteEventHandler) Handle(s *Session, i interface{}) {
-	if t, ok := i.(*GuildScheduledEventDelete); ok {
-		eh(s, t)
-	}
-}
-
 // guildMemberAddEventHandler is an event handler for GuildMemberAdd events.
 type guildMemberAddEventHandler func(*Session, *GuildMemberAdd)
 
@@ -390,6 +420,26 @@ func (eh guildMemberAddEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// guildMemberListUpdateEventHandler is an event handler for GuildMemberListUpdate events.
+type guildMemberListUpdateEventHandler func(*Session, *GuildMemberListUpdate)
+
+// Type returns the event type for GuildMemberListUpdate events.
+func (eh guildMemberListUpdateEventHandler) Type() string {
+	return guildMemberListUpdateEventType
+}
+
+// New returns a new instance of GuildMemberListUpdate.
+func (eh guildMemberListUpdateEventHandler) New() interface{} {
+	return &GuildMemberListUpdate{}
+}
+
+// Handle is the handler for GuildMemberListUpdate events.
+func (eh guildMemberListUpdateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GuildMemberListUpdate); ok {
+		eh(s, t)
+	}
+}
+
 // guildMemberRemoveEventHandler is an event handler for GuildMemberRemove events.
 type guildMemberRemoveEventHandler func(*Session, *GuildMemberRemove)
 
@@ -510,6 +560,86 @@ func (eh guildRoleUpdateEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// guildScheduledEventCreateEventHandler is an event handler for GuildScheduledEventCreate events.
+type guildScheduledEventCreateEventHandler func(*Session, *GuildScheduledEventCreate)
+
+// Type returns the event type for GuildScheduledEventCreate events.
+func (eh guildScheduledEventCreateEventHandler) Type() string {
+	return guildScheduledEventCreateEventType
+}
+
+// New returns a new instance of GuildScheduledEventCreate.
+func (eh guildScheduledEventCreateEventHandler) New() interface{} {
+	return &GuildScheduledEventCreate{}
+}
+
+// Handle is the handler for GuildScheduledEventCreate events.
+func (eh guildScheduledEventCreateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GuildScheduledEventCreate); ok {
+		eh(s, t)
+	}
+}
+
+// guildScheduledEventDeleteEventHandler is an event handler for GuildScheduledEventDelete events.
+type guildScheduledEventDeleteEventHandler func(*Session, *GuildScheduledEventDelete)
+
+// Type returns the event type for GuildScheduledEventDelete events.
+func (eh guildScheduledEventDeleteEventHandler) Type() string {
+	return guildScheduledEventDeleteEventType
+}
+
+// New returns a new instance of GuildScheduledEventDelete.
+func (eh guildScheduledEventDeleteEventHandler) New() interface{} {
+	return &GuildScheduledEventDelete{}
+}
+
+// Handle is the handler for GuildScheduledEventDelete events.
+func (eh guildScheduledEventDeleteEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GuildScheduledEventDelete); ok {
+		eh(s, t)
+	}
+}
+
+// guildScheduledEventUpdateEventHandler is an event handler for GuildScheduledEventUpdate events.
+type guildScheduledEventUpdateEventHandler func(*Session, *GuildScheduledEventUpdate)
+
+// Type returns the event type for GuildScheduledEventUpdate events.
+func (eh guildScheduledEventUpdateEventHandler) Type() string {
+	return guildScheduledEventUpdateEventType
+}
+
+// New returns a new instance of GuildScheduledEventUpdate.
+func (eh guildScheduledEventUpdateEventHandler) New() interface{} {
+	return &GuildScheduledEventUpdate{}
+}
+
+// Handle is the handler for GuildScheduledEventUpdate events.
+func (eh guildScheduledEventUpdateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GuildScheduledEventUpdate); ok {
+		eh(s, t)
+	}
+}
+
+// guildStickersUpdateEventHandler is an event handler for GuildStickersUpdate events.
+type guildStickersUpdateEventHandler func(*Session, *GuildStickersUpdate)
+
+// Type returns the event type for GuildStickersUpdate events.
+func (eh guildStickersUpdateEventHandler) Type() string {
+	return guildStickersUpdateEventType
+}
+
+// New returns a new instance of GuildStickersUpdate.
+func (eh guildStickersUpdateEventHandler) New() interface{} {
+	return &GuildStickersUpdate{}
+}
+
+// Handle is the handler for GuildStickersUpdate events.
+func (eh guildStickersUpdateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GuildStickersUpdate); ok {
+		eh(s, t)
+	}
+}
+
 // guildUpdateEventHandler is an event handler for GuildUpdate events.
 type guildUpdateEventHandler func(*Session, *GuildUpdate)
 
@@ -865,6 +995,21 @@ func (eh relationshipRemoveEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// requestRetryEventHandler is an event handler for RequestRetry events.
+type requestRetryEventHandler func(*Session, *RequestRetry)
+
+// Type returns the event type for RequestRetry events.
+func (eh requestRetryEventHandler) Type() string {
+	return requestRetryEventType
+}
+
+// Handle is the handler for RequestRetry events.
+func (eh requestRetryEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*RequestRetry); ok {
+		eh(s, t)
+	}
+}
+
 // resumedEventHandler is an event handler for Resumed events.
 type resumedEventHandler func(*Session, *Resumed)
 
@@ -885,6 +1030,66 @@ func (eh resumedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// stageInstanceCreateEventHandler is an event handler for StageInstanceCreate events.
+type stageInstanceCreateEventHandler func(*Session, *StageInstanceCreate)
+
+// Type returns the event type for StageInstanceCreate events.
+func (eh stageInstanceCreateEventHandler) Type() string {
+	return stageInstanceCreateEventType
+}
+
+// New returns a new instance of StageInstanceCreate.
+func (eh stageInstanceCreateEventHandler) New() interface{} {
+	return &StageInstanceCreate{}
+}
+
+// Handle is the handler for StageInstanceCreate events.
+func (eh stageInstanceCreateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*StageInstanceCreate); ok {
+		eh(s, t)
+	}
+}
+
+// stageInstanceDeleteEventHandler is an event handler for StageInstanceDelete events.
+type stageInstanceDeleteEventHandler func(*Session, *StageInstanceDelete)
+
+// Type returns the event type for StageInstanceDelete events.
+func (eh stageInstanceDeleteEventHandler) Type() string {
+	return stageInstanceDeleteEventType
+}
+
+// New returns a new instance of StageInstanceDelete.
+func (eh stageInstanceDeleteEventHandler) New() interface{} {
+	return &StageInstanceDelete{}
+}
+
+// Handle is the handler for StageInstanceDelete events.
+func (eh stageInstanceDeleteEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*StageInstanceDelete); ok {
+		eh(s, t)
+	}
+}
+
+// stageInstanceUpdateEventHandler is an event handler for StageInstanceUpdate events.
+type stageInstanceUpdateEventHandler func(*Session, *StageInstanceUpdate)
+
+// Type returns the event type for StageInstanceUpdate events.
+func (eh stageInstanceUpdateEventHandler) Type() string {
+	return stageInstanceUpdateEventType
+}
+
+// New returns a new instance of StageInstanceUpdate.
+func (eh stageInstanceUpdateEventHandler) New() interface{} {
+	return &StageInstanceUpdate{}
+}
+
+// Handle is the handler for StageInstanceUpdate events.
+func (eh stageInstanceUpdateEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*StageInstanceUpdate); ok {
+		eh(s, t)
+	}
+}
+
 // threadCreateEventHandler is an event handler for ThreadCreate events.
 type threadCreateEventHandler func(*Session, *ThreadCreate)
 
@@ -1169,6 +1374,14 @@ func handlerForInterface(handler interface{}) EventHandler {
 	switch v := handler.(type) {
 	case func(*Session, interface{}):
 		return interfaceEventHandler(v)
+	case func(*Session, *AutoModerationActionExecution):
+		return autoModerationActionExecutionEventHandler(v)
+	case func(*Session, *AutoModerationRuleCreate):
+		return autoModerationRuleCreateEventHandler(v)
+	case func(*Session, *AutoModerationRuleDelete):
+		return autoModerationRuleDeleteEventHandler(v)
+	case func(*Session, *AutoModerationRuleUpdate):
+		return autoModerationRuleUpdateEventHandler(v)
 	case func(*Session, *ChannelCreate):
 		return channelCreateEventHandler(v)
 	case func(*Session, *ChannelDelete):
@@ -1195,14 +1408,10 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return guildEmojisUpdateEventHandler(v)
 	case func(*Session, *GuildIntegrationsUpdate):
 		return guildIntegrationsUpdateEventHandler(v)
-	case func(*Session, *GuildScheduledEventCreate):
-		return guildScheduledEventCreateEventHandler(v)
-	case func(*Session, *GuildScheduledEventUpdate):
-		return guildScheduledEventUpdateEventHandler(v)
-	case func(*Session, *GuildScheduledEventDelete):
-		return guildScheduledEventDeleteEventHandler(v)
 	case func(*Session, *GuildMemberAdd):
 		return guildMemberAddEventHandler(v)
+	case func(*Session, *GuildMemberListUpdate):
+		return guildMemberListUpdateEventHandler(v)
 	case func(*Session, *GuildMemberRemove):
 		return guildMemberRemoveEventHandler(v)
 	case func(*Session, *GuildMemberUpdate):
@@ -1215,6 +1424,14 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return guildRoleDeleteEventHandler(v)
 	case func(*Session, *GuildRoleUpdate):
 		return guildRoleUpdateEventHandler(v)
+	case func(*Session, *GuildScheduledEventCreate):
+		return guildScheduledEventCreateEventHandler(v)
+	case func(*Session, *GuildScheduledEventDelete):
+		return guildScheduledEventDeleteEventHandler(v)
+	case func(*Session, *GuildScheduledEventUpdate):
+		return guildScheduledEventUpdateEventHandler(v)
+	case func(*Session, *GuildStickersUpdate):
+		return guildStickersUpdateEventHandler(v)
 	case func(*Session, *GuildUpdate):
 		return guildUpdateEventHandler(v)
 	case func(*Session, *InteractionCreate):
@@ -1251,8 +1468,16 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return relationshipAddEventHandler(v)
 	case func(*Session, *RelationshipRemove):
 		return relationshipRemoveEventHandler(v)
+	case func(*Session, *RequestRetry):
+		return requestRetryEventHandler(v)
 	case func(*Session, *Resumed):
 		return resumedEventHandler(v)
+	case func(*Session, *StageInstanceCreate):
+		return stageInstanceCreateEventHandler(v)
+	case func(*Session, *StageInstanceDelete):
+		return stageInstanceDeleteEventHandler(v)
+	case func(*Session, *StageInstanceUpdate):
+		return stageInstanceUpdateEventHandler(v)
 	case func(*Session, *ThreadCreate):
 		return threadCreateEventHandler(v)
 	case func(*Session, *ThreadDelete):
@@ -1287,6 +1512,10 @@ func handlerForInterface(handler interface{}) EventHandler {
 }
 
 func init() {
+	registerInterfaceProvider(autoModerationActionExecutionEventHandler(nil))
+	registerInterfaceProvider(autoModerationRuleCreateEventHandler(nil))
+	registerInterfaceProvider(autoModerationRuleDeleteEventHandler(nil))
+	registerInterfaceProvider(autoModerationRuleUpdateEventHandler(nil))
 	registerInterfaceProvider(channelCreateEventHandler(nil))
 	registerInterfaceProvider(channelDeleteEventHandler(nil))
 	registerInterfaceProvider(channelPinsUpdateEventHandler(nil))
@@ -1297,16 +1526,18 @@ func init() {
 	registerInterfaceProvider(guildDeleteEventHandler(nil))
 	registerInterfaceProvider(guildEmojisUpdateEventHandler(nil))
 	registerInterfaceProvider(guildIntegrationsUpdateEventHandler(nil))
-	registerInterfaceProvider(guildScheduledEventCreateEventHandler(nil))
-	registerInterfaceProvider(guildScheduledEventUpdateEventHandler(nil))
-	registerInterfaceProvider(guildScheduledEventDeleteEventHandler(nil))
 	registerInterfaceProvider(guildMemberAddEventHandler(nil))
+	registerInterfaceProvider(guildMemberListUpdateEventHandler(nil))
 	registerInterfaceProvider(guildMemberRemoveEventHandler(nil))
 	registerInterfaceProvider(guildMemberUpdateEventHandler(nil))
 	registerInterfaceProvider(guildMembersChunkEventHandler(nil))
 	registerInterfaceProvider(guildRoleCreateEventHandler(nil))
 	registerInterfaceProvider(guildRoleDeleteEventHandler(nil))
 	registerInterfaceProvider(guildRoleUpdateEventHandler(nil))
+	registerInterfaceProvider(guildScheduledEventCreateEventHandler(nil))
+	registerInterfaceProvider(guildScheduledEventDeleteEventHandler(nil))
+	registerInterfaceProvider(guildScheduledEventUpdateEventHandler(nil))
+	registerInterfaceProvider(guildStickersUpdateEventHandler(nil))
 	registerInterfaceProvider(guildUpdateEventHandler(nil))
 	registerInterfaceProvider(interactionCreateEventHandler(nil))
 	registerInterfaceProvider(inviteCreateEventHandler(nil))
@@ -1325,6 +1556,9 @@ func init() {
 	registerInterfaceProvider(relationshipAddEventHandler(nil))
 	registerInterfaceProvider(relationshipRemoveEventHandler(nil))
 	registerInterfaceProvider(resumedEventHandler(nil))
+	registerInterfaceProvider(stageInstanceCreateEventHandler(nil))
+	registerInterfaceProvider(stageInstanceDeleteEventHandler(nil))
+	registerInterfaceProvider(stageInstanceUpdateEventHandler(nil))
 	registerInterfaceProvider(threadCreateEventHandler(nil))
 	registerInterfaceProvider(threadDeleteEventHandler(nil))
 	registerInterfaceProvider(threadListSyncEventHandler(nil))
@@ -2,6 +2,7 @@ package astatine
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // This file contains all the possible structs that can be
@@ -24,6 +25,25 @@ type RateLimit struct {
 	URL string
 }
 
+// RequestRetry is the data for a RequestRetry event, which is sent before a
+// failed REST request is sent again.
+// This is a synthetic event and is not dispatched by Discord.
+type RequestRetry struct {
+	Method string
+	URL    string
+
+	// The number of the retry, starting at 1.
+	Attempt int
+
+	// The status code of the failed response, 0 when the request failed
+	// without a response.
+	StatusCode int
+	Err        error
+
+	// The wait before the request is sent again.
+	Delay time.Duration
+}
+
 // Event provides a basic initial struct for all websocket events.
 type Event struct {
 	Operation int             `json:"op"`
@@ -36,19 +56,20 @@ type Event struct {
 
 // A Ready stores all data for the websocket READY event.
 type Ready struct {
-	Version         int          `json:"v"`
-	SessionID       string       `json:"session_id"`
-	User            *User        `json:"user"`
-	ReadState       []*ReadState `json:"read_state"`
-	PrivateChannels []*Channel   `json:"private_channels"`
-	Guilds          []*Guild     `json:"guilds"`
+	Version          int        `json:"v"`
+	SessionID        string     `json:"session_id"`
+	ResumeGatewayURL string     `json:"resume_gateway_url"`
+	User             *User      `json:"user"`
+	ReadState        ReadStates `json:"read_state"`
+	PrivateChannels  []*Channel `json:"private_channels"`
+	Guilds           []*Guild   `json:"guilds"`
 
 	// Undocumented fields
-	Settings          *Settings            `json:"user_settings"`
-	UserGuildSettings []*UserGuildSettings `json:"user_guild_settings"`
-	Relationships     []*Relationship      `json:"relationships"`
-	Presences         []*Presence          `json:"presences"`
-	Notes             map[string]string    `json:"notes"`
+	Settings          *Settings             `json:"user_settings"`
+	UserGuildSettings UserGuildSettingsList `json:"user_guild_settings"`
+	Relationships     []*Relationship       `json:"relationships"`
+	Presences         []*Presence           `json:"presences"`
+	Notes             map[string]string     `json:"notes"`
 }
 
 // ChannelCreate is the data for a ChannelCreate event.
@@ -163,6 +184,16 @@ type GuildMemberRemove struct {
 	*Member
 }
 
+// GuildMemberListUpdate is the data for a GuildMemberListUpdate event.
+type GuildMemberListUpdate struct {
+	ID          string             `json:"id"`
+	GuildID     string             `json:"guild_id"`
+	MemberCount int                `json:"member_count"`
+	OnlineCount int                `json:"online_count"`
+	Groups      []*MemberListGroup `json:"groups"`
+	Ops         []*MemberListOp    `json:"ops"`
+}
+
 // GuildRoleCreate is the data for a GuildRoleCreate event.
 type GuildRoleCreate struct {
 	*GuildRole
@@ -185,6 +216,12 @@ type GuildEmojisUpdate struct {
 	Emojis  []*Emoji `json:"emojis"`
 }
 
+// A GuildStickersUpdate is the data for a guild sticker update event.
+type GuildStickersUpdate struct {
+	GuildID  string     `json:"guild_id"`
+	Stickers []*Sticker `json:"stickers"`
+}
+
 // A GuildMembersChunk is the data for a GuildMembersChunk event.
 type GuildMembersChunk struct {
 	GuildID    string      `json:"guild_id"`
@@ -214,6 +251,58 @@ type GuildScheduledEventDelete struct {
 	*GuildScheduledEvent
 }
 
+// StageInstanceCreate is the data for a StageInstanceCreate event.
+type StageInstanceCreate struct {
+	*StageInstance
+}
+
+// StageInstanceUpdate is the data for a StageInstanceUpdate event.
+type StageInstanceUpdate struct {
+	*StageInstance
+}
+
+// StageInstanceDelete is the data for a StageInstanceDelete event.
+type StageInstanceDelete struct {
+	*StageInstance
+}
+
+// AutoModerationRuleCreate is the data for an AutoModerationRuleCreate event.
+type AutoModerationRuleCreate struct {
+	*AutoModerationRule
+}
+
+// AutoModerationRuleUpdate is the data for an AutoModerationRuleUpdate event.
+type AutoModerationRuleUpdate struct {
+	*AutoModerationRule
+}
+
+// AutoModerationRuleDelete is the data for an AutoModerationRuleDelete event.
+type AutoModerationRuleDelete struct {
+	*AutoModerationRule
+}
+
+// AutoModerationActionExecution is the data for an AutoModerationActionExecution event,
+// which is sent when an auto moderation rule was triggered and its action was executed.
+type AutoModerationActionExecution struct {
+	GuildID         string                        `json:"guild_id"`
+	Action          AutoModerationAction          `json:"action"`
+	RuleID          string                        `json:"rule_id"`
+	RuleTriggerType AutoModerationRuleTriggerType `json:"rule_trigger_type"`
+	UserID          string                        `json:"user_id"`
+	ChannelID       string                        `json:"channel_id"`
+	MessageID       string                        `json:"message_id"`
+
+	// The ID of the message logged by a send alert message action.
+	AlertSystemMessageID string `json:"alert_system_message_id"`
+
+	// The content of the message, the keyword which triggered the rule
+	// and the content it matched. Content and MatchedContent are empty
+	// without the MessageContent intent.
+	Content        string `json:"content"`
+	MatchedKeyword string `json:"matched_keyword"`
+	MatchedContent string `json:"matched_content"`
+}
+
 // MessageAck is the data for a MessageAck event.
 type MessageAck struct {
 	MessageID string `json:"message_id"`
@@ -8,6 +8,8 @@
 // This file contains variables for all known Discord end points.  All functions
 // throughout the Discordgo package use these variables for all connections
 // to Discord.  These are all exported and you may modify them if needed.
+// To send the requests of a single Session elsewhere, set its APIBase and
+// CDNBase instead.
 
 package http
 
@@ -23,15 +25,16 @@ var (
 	EndpointSmActive   = EndpointSm + "active.json"
 	EndpointSmUpcoming = EndpointSm + "upcoming.json"
 
-	EndpointDiscord    = "https://discord.com/"
-	EndpointAPI        = EndpointDiscord + "api/v" + APIVersion + "/"
-	EndpointGuilds     = EndpointAPI + "guilds/"
-	EndpointChannels   = EndpointAPI + "channels/"
-	EndpointUsers      = EndpointAPI + "users/"
-	EndpointGateway    = EndpointAPI + "gateway"
-	EndpointGatewayBot = EndpointGateway + "/bot"
-	EndpointWebhooks   = EndpointAPI + "webhooks/"
-	EndpointStickers   = EndpointAPI + "stickers/"
+	EndpointDiscord        = "https://discord.com/"
+	EndpointAPI            = EndpointDiscord + "api/v" + APIVersion + "/"
+	EndpointGuilds         = EndpointAPI + "guilds/"
+	EndpointChannels       = EndpointAPI + "channels/"
+	EndpointUsers          = EndpointAPI + "users/"
+	EndpointGateway        = EndpointAPI + "gateway"
+	EndpointGatewayBot     = EndpointGateway + "/bot"
+	EndpointWebhooks       = EndpointAPI + "webhooks/"
+	EndpointStickers       = EndpointAPI + "stickers/"
+	EndpointStageInstances = EndpointAPI + "stage-instances"
 
 	EndpointCDN             = "https://cdn.discordapp.com/"
 	EndpointCDNAttachments  = EndpointCDN + "attachments/"
@@ -42,9 +45,16 @@ var (
 	EndpointCDNBanners      = EndpointCDN + "banners/"
 	EndpointCDNGuilds       = EndpointCDN + "guilds/"
 
-	EndpointAuth  = EndpointAPI + "auth/"
-	EndpointLogin = EndpointAuth + "login"
-	EndpointTotp  = EndpointAuth + "mfa/totp"
+	EndpointAuth    = EndpointAPI + "auth/"
+	EndpointLogin   = EndpointAuth + "login"
+	EndpointTotp    = EndpointAuth + "mfa/totp"
+	EndpointSms     = EndpointAuth + "mfa/sms"
+	EndpointSmsSend = EndpointAuth + "mfa/sms/send"
+	EndpointBackup  = EndpointAuth + "mfa/backup"
+
+	EndpointRemoteAuthGateway = "wss://remote-auth-gateway.discord.gg/?v=2"
+	EndpointRemoteAuthLogin   = EndpointUsers + "@me/remote-auth/login"
+	EndpointRemoteAuthQR      = func(fingerprint string) string { return EndpointDiscord + "ra/" + fingerprint }
 
 	EndpointVoice        = EndpointAPI + "/voice/"
 	EndpointVoiceRegions = EndpointVoice + "regions"
@@ -65,10 +75,17 @@ var (
 		return EndpointCDNBanners + uID + "/" + cID + ".gif"
 	}
 
-	EndpointUserGuilds      = func(uID string) string { return EndpointUsers + uID + "/guilds" }
-	EndpointUserGuild       = func(uID, gID string) string { return EndpointUsers + uID + "/guilds/" + gID }
-	EndpointUserChannels    = func(uID string) string { return EndpointUsers + uID + "/channels" }
-	EndpointUserConnections = func(uID string) string { return EndpointUsers + uID + "/connections" }
+	EndpointUserGuilds        = func(uID string) string { return EndpointUsers + uID + "/guilds" }
+	EndpointUserGuild         = func(uID, gID string) string { return EndpointUsers + uID + "/guilds/" + gID }
+	EndpointUserChannels      = func(uID string) string { return EndpointUsers + uID + "/channels" }
+	EndpointUserConnections   = func(uID string) string { return EndpointUsers + uID + "/connections" }
+	EndpointUserSettings      = func(uID string) string { return EndpointUsers + uID + "/settings" }
+	EndpointUserGuildSettings = func(uID, gID string) string { return EndpointUsers + uID + "/guilds/" + gID + "/settings" }
+	EndpointUserNotes         = func(uID string) string { return EndpointUsers + "@me/notes/" + uID }
+
+	EndpointRelationships       = EndpointUsers + "@me/relationships"
+	EndpointRelationship        = func(uID string) string { return EndpointRelationships + "/" + uID }
+	EndpointRelationshipsMutual = func(uID string) string { return EndpointUsers + uID + "/relationships" }
 
 	EndpointGuild                    = func(gID string) string { return EndpointGuilds + gID }
 	EndpointGuildThreads             = func(gID string) string { return EndpointGuild(gID) + "/threads" }
@@ -88,6 +105,7 @@ var (
 	EndpointGuildWidget              = func(gID string) string { return EndpointGuilds + gID + "/widget" }
 	EndpointGuildEmbed               = EndpointGuildWidget
 	EndpointGuildPrune               = func(gID string) string { return EndpointGuilds + gID + "/prune" }
+	EndpointGuildAck                 = func(gID string) string { return EndpointGuilds + gID + "/ack" }
 	EndpointGuildIcon                = func(gID, hash string) string { return EndpointCDNIcons + gID + "/" + hash + ".png" }
 	EndpointGuildIconAnimated        = func(gID, hash string) string { return EndpointCDNIcons + gID + "/" + hash + ".gif" }
 	EndpointGuildSplash              = func(gID, hash string) string { return EndpointCDNSplashes + gID + "/" + hash + ".png" }
@@ -101,6 +119,9 @@ var (
 	EndpointGuildScheduledEvents     = func(gID string) string { return EndpointGuilds + gID + "/scheduled-events" }
 	EndpointGuildScheduledEvent      = func(gID, eID string) string { return EndpointGuilds + gID + "/scheduled-events/" + eID }
 	EndpointGuildScheduledEventUsers = func(gID, eID string) string { return EndpointGuildScheduledEvent(gID, eID) + "/users" }
+	EndpointGuildAutoModerationRules = func(gID string) string { return EndpointGuilds + gID + "/auto-moderation/rules" }
+	EndpointGuildAutoModerationRule  = func(gID, rID string) string { return EndpointGuildAutoModerationRules(gID) + "/" + rID }
+	EndpointGuildVoiceState          = func(gID, uID string) string { return EndpointGuilds + gID + "/voice-states/" + uID }
 	EndpointGuildTemplate            = func(tID string) string { return EndpointGuilds + "/templates/" + tID }
 	EndpointGuildTemplates           = func(gID string) string { return EndpointGuilds + gID + "/templates" }
 	EndpointGuildTemplateSync        = func(gID, tID string) string { return EndpointGuilds + gID + "/templates/" + tID }
@@ -124,6 +145,7 @@ var (
 	EndpointChannelMessages                     = func(cID string) string { return EndpointChannels + cID + "/messages" }
 	EndpointChannelMessage                      = func(cID, mID string) string { return EndpointChannels + cID + "/messages/" + mID }
 	EndpointChannelMessageThread                = func(cID, mID string) string { return EndpointChannelMessage(cID, mID) + "/threads" }
+	EndpointChannelMessageAck                   = func(cID, mID string) string { return EndpointChannelMessage(cID, mID) + "/ack" }
 	EndpointChannelMessagesBulkDelete           = func(cID string) string { return EndpointChannel(cID) + "/messages/bulk-delete" }
 	EndpointChannelMessagesPins                 = func(cID string) string { return EndpointChannel(cID) + "/pins" }
 	EndpointChannelMessagePin                   = func(cID, mID string) string { return EndpointChannel(cID) + "/pins/" + mID }
@@ -134,8 +156,10 @@ var (
 
 	EndpointGroupIcon = func(cID, hash string) string { return EndpointCDNChannelIcons + cID + "/" + hash + ".png" }
 
+	EndpointStageInstance = func(cID string) string { return EndpointStageInstances + "/" + cID }
+
 	EndpointSticker            = func(sID string) string { return EndpointStickers + sID }
-	EndpointNitroStickersPacks = EndpointAPI + "/sticker-packs"
+	EndpointNitroStickersPacks = EndpointAPI + "sticker-packs"
 
 	EndpointChannelWebhooks = func(cID string) string { return EndpointChannel(cID) + "/webhooks" }
 	EndpointWebhook         = func(wID string) string { return EndpointWebhooks + wID }
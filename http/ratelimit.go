@@ -1,7 +1,9 @@
 package http
 
 import (
+	"context"
 	"math"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,6 +19,47 @@ type customRateLimit struct {
 	reset    time.Duration
 }
 
+// A Limiter decides when REST requests can be made. RateLimiter is the default
+// in-process Limiter, and RemoteRateLimiter shares one between processes.
+type Limiter interface {
+	// LockBucketContext locks the bucket of bucketID until a request can
+	// be made, or returns the context's error once ctx is done.
+	LockBucketContext(ctx context.Context, bucketID string) (*Bucket, error)
+
+	// LockBucketObjectContext is like LockBucketContext, for a bucket
+	// which was already locked and released once.
+	LockBucketObjectContext(ctx context.Context, b *Bucket) (*Bucket, error)
+
+	// ReleaseBucket unlocks a bucket once its request is done, updating
+	// its ratelimit from the response headers. headers are nil when the
+	// request failed.
+	ReleaseBucket(b *Bucket, headers http.Header) error
+}
+
+// NopLimiter is a Limiter which never waits, for use behind a proxy which
+// already handles ratelimits.
+var NopLimiter Limiter = nopLimiter{}
+
+type nopLimiter struct{}
+
+func (nopLimiter) LockBucketContext(ctx context.Context, bucketID string) (*Bucket, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &Bucket{Key: bucketID}, nil
+}
+
+func (nopLimiter) LockBucketObjectContext(ctx context.Context, b *Bucket) (*Bucket, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (nopLimiter) ReleaseBucket(b *Bucket, headers http.Header) error {
+	return nil
+}
+
 // RateLimiter holds all ratelimit buckets
 type RateLimiter struct {
 	sync.Mutex
@@ -24,6 +67,9 @@ type RateLimiter struct {
 	buckets          map[string]*Bucket
 	globalRateLimit  time.Duration
 	customRateLimits []*customRateLimit
+
+	// routes maps route templates to the bucket hash Discord gave them
+	routes map[string]string
 }
 
 // NewRatelimiter returns a new RateLimiter
@@ -31,6 +77,7 @@ func NewRatelimiter() *RateLimiter {
 
 	return &RateLimiter{
 		buckets: make(map[string]*Bucket),
+		routes:  make(map[string]string),
 		global:  new(int64),
 		customRateLimits: []*customRateLimit{
 			{
@@ -42,12 +89,108 @@ func NewRatelimiter() *RateLimiter {
 	}
 }
 
-// GetBucket retrieves or creates a bucket
+// majorParameters are the path segments which are followed by a major
+// parameter. Routes with different major parameters never share a bucket.
+var majorParameters = map[string]bool{
+	"channels": true,
+	"guilds":   true,
+	"webhooks": true,
+}
+
+// isSnowflake reports whether a path segment is an ID.
+func isSnowflake(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// RouteTemplate returns the route of a bucket ID, with the IDs which aren't
+// major parameters replaced by placeholders. Requests for the different
+// messages, members, etc. of a channel or guild then share a route.
+func RouteTemplate(bucketID string) string {
+	parts := strings.Split(strings.SplitN(bucketID, "?", 2)[0], "/")
+
+	for i := 1; i < len(parts); i++ {
+		switch prev := parts[i-1]; {
+		case majorParameters[prev]:
+		case i > 1 && parts[i-2] == "webhooks":
+			// The webhook token is a major parameter along with the
+			// webhook ID.
+		case i > 1 && parts[i-2] == "interactions":
+			parts[i] = ":token"
+		case prev == "reactions" && parts[i] != "":
+			parts[i] = ":emoji"
+		case isSnowflake(parts[i]):
+			parts[i] = ":id"
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// majorParameter returns the first major parameter of a route template,
+// along with the path segment that precedes it.
+func majorParameter(route string) string {
+	parts := strings.Split(route, "/")
+
+	for i := 0; i < len(parts)-1; i++ {
+		if !majorParameters[parts[i]] {
+			continue
+		}
+
+		major := parts[i] + "/" + parts[i+1]
+		if parts[i] == "webhooks" && i+2 < len(parts) {
+			major += "/" + parts[i+2]
+		}
+		return major
+	}
+
+	return ""
+}
+
+// hashRoute returns a route template with its major parameters replaced by
+// placeholders. Discord gives the same bucket hash to a route whatever its
+// major parameters are.
+func hashRoute(route string) string {
+	parts := strings.Split(route, "/")
+
+	for i := 1; i < len(parts); i++ {
+		if majorParameters[parts[i-1]] || (i > 1 && parts[i-2] == "webhooks") {
+			parts[i] = ":major"
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// GetBucket retrieves or creates a bucket.
+// Buckets are keyed by the route template of key. Once Discord has given a
+// route a bucket hash, the bucket shared by every route with that hash and
+// the same major parameter is returned.
 func (r *RateLimiter) GetBucket(key string) *Bucket {
 	r.Lock()
 	defer r.Unlock()
 
+	key = RouteTemplate(key)
+
+	var hashKey string
+	if hash, ok := r.routes[hashRoute(key)]; ok {
+		hashKey = hashBucketKey(hash, key)
+		if bucket, ok := r.buckets[hashKey]; ok {
+			return bucket
+		}
+	}
+
 	if bucket, ok := r.buckets[key]; ok {
+		if hashKey != "" {
+			r.buckets[hashKey] = bucket
+		}
 		return bucket
 	}
 
@@ -55,6 +198,7 @@ func (r *RateLimiter) GetBucket(key string) *Bucket {
 		Remaining: 1,
 		Key:       key,
 		global:    r.global,
+		limiter:   r,
 	}
 
 	// Check if there is a custom ratelimit set for this bucket ID.
@@ -66,9 +210,32 @@ func (r *RateLimiter) GetBucket(key string) *Bucket {
 	}
 
 	r.buckets[key] = b
+	if hashKey != "" {
+		r.buckets[hashKey] = b
+	}
 	return b
 }
 
+// hashBucketKey returns the key of the bucket shared by the routes with the
+// given hash and the major parameter of route.
+func hashBucketKey(hash, route string) string {
+	return "hash:" + hash + ":" + majorParameter(route)
+}
+
+// setBucketHash records the bucket hash Discord gave the route of b. The first
+// bucket to get a hash becomes the bucket shared by every route with it.
+func (r *RateLimiter) setBucketHash(b *Bucket, hash string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.routes[hashRoute(b.Key)] = hash
+
+	key := hashBucketKey(hash, b.Key)
+	if _, ok := r.buckets[key]; !ok {
+		r.buckets[key] = b
+	}
+}
+
 // GetWaitTime returns the duration you should wait for a Bucket
 func (r *RateLimiter) GetWaitTime(b *Bucket, minRemaining int) time.Duration {
 	// If we ran out of calls and the reset time is still ahead of us
@@ -93,19 +260,55 @@ func (r *RateLimiter) LockBucket(bucketID string) *Bucket {
 
 // LockBucketObject Locks an already resolved bucket until a request can be made
 func (r *RateLimiter) LockBucketObject(b *Bucket) *Bucket {
-	b.Lock()
+	b, _ = r.LockBucketObjectContext(context.Background(), b)
+	return b
+}
+
+// LockBucketContext is like LockBucket, but gives up waiting and returns the
+// context's error once ctx is done.
+func (r *RateLimiter) LockBucketContext(ctx context.Context, bucketID string) (*Bucket, error) {
+	return r.LockBucketObjectContext(ctx, r.GetBucket(bucketID))
+}
+
+// LockBucketObjectContext is like LockBucketObject, but gives up waiting and
+// returns the context's error once ctx is done. The bucket is left unlocked
+// when an error is returned.
+func (r *RateLimiter) LockBucketObjectContext(ctx context.Context, b *Bucket) (*Bucket, error) {
+	if err := b.lockContext(ctx); err != nil {
+		return nil, err
+	}
 
 	if wait := r.GetWaitTime(b, 1); wait > 0 {
-		time.Sleep(wait)
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			b.Unlock()
+			return nil, ctx.Err()
+		}
 	}
 
 	b.Remaining--
-	return b
+	return b, nil
+}
+
+// ReleaseBucket releases a bucket, see Bucket.Release.
+func (r *RateLimiter) ReleaseBucket(b *Bucket, headers http.Header) error {
+	return b.Release(headers)
 }
 
 // Bucket represents a ratelimit bucket, each bucket gets ratelimited individually (-global ratelimits)
+// The zero value is an unlocked bucket without ratelimit info, ready to use.
 type Bucket struct {
-	sync.Mutex
+	// lock is a one slot semaphore used instead of a sync.Mutex so that
+	// waiting for the bucket can be abandoned. It's created on first use,
+	// see semaphore.
+	lock     chan struct{}
+	lockOnce sync.Once
+
+	// Key is the route template the bucket was created for. Buckets
+	// shared through a bucket hash keep the key of their first route.
 	Key       string
 	Remaining int
 	limit     int
@@ -115,6 +318,40 @@ type Bucket struct {
 	lastReset       time.Time
 	customRateLimit *customRateLimit
 	Userdata        interface{}
+
+	limiter *RateLimiter
+
+	// conn holds the bucket of a RemoteRateLimiter until it's released.
+	conn net.Conn
+}
+
+// semaphore returns the lock of the bucket, creating it if needed.
+func (b *Bucket) semaphore() chan struct{} {
+	b.lockOnce.Do(func() {
+		b.lock = make(chan struct{}, 1)
+	})
+	return b.lock
+}
+
+// Lock locks the bucket, blocking until it is available.
+func (b *Bucket) Lock() {
+	b.semaphore() <- struct{}{}
+}
+
+// Unlock unlocks the bucket.
+func (b *Bucket) Unlock() {
+	<-b.semaphore()
+}
+
+// lockContext locks the bucket, or returns the context's error if ctx is done
+// before the bucket becomes available.
+func (b *Bucket) lockContext(ctx context.Context) error {
+	select {
+	case b.semaphore() <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Release unlocks the bucket and reads the headers to update the buckets ratelimit info
@@ -142,6 +379,17 @@ func (b *Bucket) Release(headers http.Header) error {
 	reset := headers.Get("X-RateLimit-Reset")
 	global := headers.Get("X-RateLimit-Global")
 	resetAfter := headers.Get("X-RateLimit-Reset-After")
+	hash := headers.Get("X-RateLimit-Bucket")
+
+	// A 429 with a global scope applies to every bucket, like the global
+	// header. The user and shared scopes only apply to this bucket.
+	if headers.Get("X-RateLimit-Scope") == "global" {
+		global = "true"
+	}
+
+	if hash != "" && b.limiter != nil {
+		b.limiter.setBucketHash(b, hash)
+	}
 
 	// Update global and per bucket reset time if the proper headers are available
 	// If global is set, then it will block all buckets until after Retry-After
@@ -158,7 +406,7 @@ func (b *Bucket) Release(headers http.Header) error {
 		resetAt := time.Now().Add(time.Duration(whole) * time.Second).Add(time.Duration(frac*1000) * time.Millisecond)
 
 		// Lock either this single bucket or all buckets
-		if global != "" {
+		if global != "" && b.global != nil {
 			atomic.StoreInt64(b.global, resetAt.UnixNano())
 		} else {
 			b.reset = resetAt
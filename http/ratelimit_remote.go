@@ -0,0 +1,152 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// ratelimitMessage is exchanged between a RemoteRateLimiter and the
+// ratelimiter served by ServeRatelimiter. Every lock uses one connection:
+// the client sends the bucket to lock, the server replies once it's locked,
+// and the client sends the response headers to release it.
+type ratelimitMessage struct {
+	Bucket  string      `json:"bucket,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// ServeRatelimiter serves the buckets of r to the RemoteRateLimiters which
+// connect through l, so that several processes share the same rate limits.
+// It returns when accepting a connection fails, e.g. once l is closed.
+func ServeRatelimiter(l net.Listener, r *RateLimiter) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go serveRatelimitConn(conn, r)
+	}
+}
+
+func serveRatelimitConn(conn net.Conn, r *RateLimiter) {
+	defer conn.Close()
+
+	dec := json.NewDecoder(conn)
+
+	var req ratelimitMessage
+	if err := dec.Decode(&req); err != nil {
+		return
+	}
+
+	// The client gives up a bucket by closing the connection, while
+	// waiting for it or while holding it.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan http.Header, 1)
+	go func() {
+		var m ratelimitMessage
+		if err := dec.Decode(&m); err != nil {
+			cancel()
+			release <- nil
+			return
+		}
+		release <- m.Headers
+	}()
+
+	b, err := r.LockBucketContext(ctx, req.Bucket)
+	if err != nil {
+		json.NewEncoder(conn).Encode(ratelimitMessage{Error: err.Error()})
+		return
+	}
+
+	if err = json.NewEncoder(conn).Encode(ratelimitMessage{}); err != nil {
+		b.Release(nil)
+		return
+	}
+
+	b.Release(<-release)
+}
+
+// A RemoteRateLimiter is a Limiter which locks buckets in a RateLimiter
+// served by ServeRatelimiter, usually in another process.
+type RemoteRateLimiter struct {
+	// Dial connects to the served RateLimiter.
+	Dial func(ctx context.Context) (net.Conn, error)
+}
+
+// NewRemoteRateLimiter returns a RemoteRateLimiter connecting to address on
+// the named network, e.g. "unix" and a socket path, or "tcp" and a host:port.
+func NewRemoteRateLimiter(network, address string) *RemoteRateLimiter {
+	var d net.Dialer
+	return &RemoteRateLimiter{
+		Dial: func(ctx context.Context) (net.Conn, error) {
+			return d.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// LockBucketContext locks the bucket of bucketID in the served RateLimiter.
+// The bucket is held until it's released with ReleaseBucket.
+func (r *RemoteRateLimiter) LockBucketContext(ctx context.Context, bucketID string) (*Bucket, error) {
+	conn, err := r.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closing the connection stops the wait for the bucket.
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	var resp ratelimitMessage
+	err = json.NewEncoder(conn).Encode(ratelimitMessage{Bucket: bucketID})
+	if err == nil {
+		err = json.NewDecoder(conn).Decode(&resp)
+	}
+
+	close(stop)
+	<-stopped
+
+	if ctx.Err() != nil {
+		conn.Close()
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.Error != "" {
+		conn.Close()
+		return nil, errors.New(resp.Error)
+	}
+
+	return &Bucket{Key: bucketID, conn: conn}, nil
+}
+
+// LockBucketObjectContext locks a released bucket again.
+func (r *RemoteRateLimiter) LockBucketObjectContext(ctx context.Context, b *Bucket) (*Bucket, error) {
+	return r.LockBucketContext(ctx, b.Key)
+}
+
+// ReleaseBucket sends the response headers to the served RateLimiter, which
+// releases the bucket.
+func (r *RemoteRateLimiter) ReleaseBucket(b *Bucket, headers http.Header) error {
+	if b.conn == nil {
+		return nil
+	}
+	defer b.conn.Close()
+
+	return json.NewEncoder(b.conn).Encode(ratelimitMessage{Headers: headers})
+}
@@ -1,7 +1,10 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 	"testing"
@@ -81,6 +84,169 @@ func TestRatelimitGlobal(t *testing.T) {
 	}
 }
 
+// This test takes ~100 milliseconds to run
+func TestRatelimitContext(t *testing.T) {
+	rl := NewRatelimiter()
+
+	// Hold the bucket so that the next lock has to wait for it.
+	bucket := rl.LockBucket("/guilds/99/channels")
+	defer bucket.Release(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	sent := time.Now()
+	_, err := rl.LockBucketContext(ctx, "/guilds/99/channels")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("LockBucketContext returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if time.Since(sent) >= time.Second {
+		t.Error("Did not give up waiting on the bucket, got:", time.Since(sent))
+	}
+}
+
+func TestBucketZeroValue(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		var b Bucket
+		b.Lock()
+		b.Release(http.Header{"X-Ratelimit-Global": {"true"}, "X-Ratelimit-Reset-After": {"0"}})
+		if err := b.lockContext(context.Background()); err != nil {
+			t.Errorf("lockContext returned error: %v", err)
+		}
+		b.Unlock()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("zero value Bucket blocked")
+	}
+}
+
+func TestRouteTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/channels/99/messages/123?limit=10":    "/channels/99/messages/:id",
+		"/guilds/99/members/123":                "/guilds/99/members/:id",
+		"/channels/99/messages//reactions//":    "/channels/99/messages//reactions//",
+		"/channels/99/messages/1/reactions/a/2": "/channels/99/messages/:id/reactions/:emoji/:id",
+		"/webhooks/99/token/messages/123":       "/webhooks/99/token/messages/:id",
+		"/interactions/123/token/callback":      "/interactions/:id/:token/callback",
+		"/users/@me/guilds/99":                  "/users/@me/guilds/99",
+	}
+
+	for bucketID, want := range tests {
+		if got := RouteTemplate(bucketID); got != want {
+			t.Errorf("RouteTemplate(%q) = %q, want %q", bucketID, got, want)
+		}
+	}
+}
+
+// This test takes ~1 second to run
+func TestRatelimitBucketHash(t *testing.T) {
+	rl := NewRatelimiter()
+
+	sendReq := func(endpoint string) {
+		bucket := rl.LockBucket(endpoint)
+
+		headers := http.Header(make(map[string][]string))
+
+		headers.Set("X-RateLimit-Bucket", "abcd")
+		headers.Set("X-RateLimit-Remaining", "0")
+		headers.Set("X-RateLimit-Reset-After", "1")
+
+		err := bucket.Release(headers)
+		if err != nil {
+			t.Errorf("Release returned error: %v", err)
+		}
+	}
+
+	// Both routes learn that they share the abcd bucket.
+	sendReq("/channels/99/messages/1")
+	sendReq("/channels/99/pins")
+
+	if rl.GetBucket("/channels/99/pins") != rl.GetBucket("/channels/99/messages/2") {
+		t.Fatal("routes with the same bucket hash do not share a bucket")
+	}
+	if rl.GetBucket("/channels/99/pins") == rl.GetBucket("/channels/55/pins") {
+		t.Fatal("routes with different major parameters share a bucket")
+	}
+	if rl.GetBucket("/channels/55/pins") != rl.GetBucket("/channels/55/messages/2") {
+		t.Fatal("bucket hashes are not shared between major parameters")
+	}
+
+	// The pins route is now limited by the messages route.
+	sent := time.Now()
+	sendReq("/channels/99/pins")
+
+	if time.Since(sent) >= time.Millisecond*500 && time.Since(sent) < time.Second*2 {
+		t.Log("OK", time.Since(sent))
+	} else {
+		t.Error("Did not ratelimit correctly, got:", time.Since(sent))
+	}
+}
+
+// This test takes ~1 second to run
+func TestRemoteRateLimiter(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen returned error: %v", err)
+	}
+	defer l.Close()
+	go ServeRatelimiter(l, NewRatelimiter())
+
+	rl := NewRemoteRateLimiter("tcp", l.Addr().String())
+
+	sendReq := func(endpoint string) {
+		bucket, err := rl.LockBucketContext(context.Background(), endpoint)
+		if err != nil {
+			t.Fatalf("LockBucketContext returned error: %v", err)
+		}
+
+		headers := http.Header(make(map[string][]string))
+
+		headers.Set("X-RateLimit-Remaining", "0")
+		headers.Set("X-RateLimit-Reset-After", "1")
+
+		err = rl.ReleaseBucket(bucket, headers)
+		if err != nil {
+			t.Errorf("ReleaseBucket returned error: %v", err)
+		}
+	}
+
+	sent := time.Now()
+	sendReq("/guilds/99/channels")
+	sendReq("/guilds/99/channels")
+
+	if time.Since(sent) >= time.Millisecond*500 && time.Since(sent) < time.Second*2 {
+		t.Log("OK", time.Since(sent))
+	} else {
+		t.Error("Did not ratelimit correctly, got:", time.Since(sent))
+	}
+
+	// A held bucket makes other locks wait until their context is done.
+	bucket, err := rl.LockBucketContext(context.Background(), "/guilds/55/channels")
+	if err != nil {
+		t.Fatalf("LockBucketContext returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	if _, err = rl.LockBucketContext(ctx, "/guilds/55/channels"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("LockBucketContext returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	rl.ReleaseBucket(bucket, nil)
+	bucket, err = rl.LockBucketContext(context.Background(), "/guilds/55/channels")
+	if err != nil {
+		t.Fatalf("LockBucketContext returned error: %v", err)
+	}
+	rl.ReleaseBucket(bucket, nil)
+}
+
 func BenchmarkRatelimitSingleEndpoint(b *testing.B) {
 	rl := NewRatelimiter()
 	for i := 0; i < b.N; i++ {
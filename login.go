@@ -2,23 +2,34 @@ package astatine
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/ayntgl/astatine/http"
 )
 
+// ErrLoginChallenge is returned when answering a challenge which the login
+// didn't ask for.
+var ErrLoginChallenge = errors.New("login challenge is not pending")
+
 type LoginResponse struct {
+	UserID string `json:"user_id"`
 	Mfa    bool   `json:"mfa"`
 	Sms    bool   `json:"sms"`
+	Totp   bool   `json:"totp"`
+	Backup bool   `json:"backup"`
 	Ticket string `json:"ticket"`
 	Token  string `json:"token"`
 }
 
-func (s *Session) Login(email, password string) (*LoginResponse, error) {
+func (s *Session) Login(email, password string, options ...RequestOption) (*LoginResponse, error) {
 	data := struct {
 		Email    string `json:"email"`
 		Password string `json:"password"`
 	}{email, password}
-	resp, err := s.RequestWithBucketID("POST", http.EndpointLogin, data, http.EndpointLogin)
+	resp, err := s.RequestWithBucketID("POST", http.EndpointLogin, data, http.EndpointLogin, options...)
+	if err != nil {
+		return nil, err
+	}
 
 	var lr *LoginResponse
 	err = json.Unmarshal(resp, &lr)
@@ -29,12 +40,12 @@ func (s *Session) Login(email, password string) (*LoginResponse, error) {
 	return lr, nil
 }
 
-func (s *Session) Totp(code, ticket string) (*LoginResponse, error) {
+func (s *Session) Totp(code, ticket string, options ...RequestOption) (*LoginResponse, error) {
 	data := struct {
 		Code   string `json:"code"`
 		Ticket string `json:"ticket"`
 	}{code, ticket}
-	resp, err := s.RequestWithBucketID("POST", http.EndpointTotp, data, http.EndpointTotp)
+	resp, err := s.RequestWithBucketID("POST", http.EndpointTotp, data, http.EndpointTotp, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,3 +58,192 @@ func (s *Session) Totp(code, ticket string) (*LoginResponse, error) {
 
 	return lr, nil
 }
+
+// LoginChallenge is a challenge which must be answered to continue a login.
+type LoginChallenge string
+
+// Valid LoginChallenge values
+const (
+	LoginChallengeTotp    LoginChallenge = "totp"
+	LoginChallengeSms     LoginChallenge = "sms"
+	LoginChallengeBackup  LoginChallenge = "backup"
+	LoginChallengeCaptcha LoginChallenge = "captcha"
+)
+
+// CaptchaChallenge holds the captcha which Discord requires to be solved
+// before accepting a request.
+type CaptchaChallenge struct {
+	// The captcha service, e.g. "hcaptcha".
+	Service string `json:"captcha_service"`
+	Sitekey string `json:"captcha_sitekey"`
+	RqData  string `json:"captcha_rqdata"`
+	RqToken string `json:"captcha_rqtoken"`
+}
+
+// A LoginFlow logs a user account in step by step. After every step, either
+// Token is set, or Challenges lists the challenges which can be answered to
+// continue, with the methods of the flow.
+type LoginFlow struct {
+	// The token of the account once logged in.
+	Token string
+
+	// The challenges of which one must be answered to continue.
+	Challenges []LoginChallenge
+
+	// The captcha to solve, when Challenges is LoginChallengeCaptcha.
+	Captcha *CaptchaChallenge
+
+	session *Session
+	options []RequestOption
+	ticket  string
+
+	// The last request, sent again with the solved captcha.
+	endpoint string
+	data     map[string]interface{}
+}
+
+// LoginFlow starts logging a user account in with its email (or phone
+// number) and password. Once the login succeeds, the token is also set as
+// the token of the session.
+func (s *Session) LoginFlow(login, password string, options ...RequestOption) (*LoginFlow, error) {
+	f := &LoginFlow{session: s, options: options}
+
+	err := f.submit(http.EndpointLogin, map[string]interface{}{
+		"login":    login,
+		"password": password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Done returns whether the account is logged in.
+func (f *LoginFlow) Done() bool {
+	return f.Token != ""
+}
+
+// Pending returns whether challenge is one of the challenges of the flow.
+func (f *LoginFlow) Pending(challenge LoginChallenge) bool {
+	for _, c := range f.Challenges {
+		if c == challenge {
+			return true
+		}
+	}
+	return false
+}
+
+// SolveCaptcha sends the last request again with the response of the
+// solved captcha.
+func (f *LoginFlow) SolveCaptcha(captchaKey string) error {
+	if !f.Pending(LoginChallengeCaptcha) {
+		return ErrLoginChallenge
+	}
+
+	data := make(map[string]interface{}, len(f.data)+2)
+	for k, v := range f.data {
+		data[k] = v
+	}
+	data["captcha_key"] = captchaKey
+	data["captcha_rqtoken"] = f.Captcha.RqToken
+
+	return f.submit(f.endpoint, data)
+}
+
+// SubmitTotp answers the two-factor challenge with a code from an
+// authenticator app.
+func (f *LoginFlow) SubmitTotp(code string) error {
+	return f.submitMfa(LoginChallengeTotp, http.EndpointTotp, code)
+}
+
+// SendSms sends a code by SMS to the phone of the account, to be answered
+// with SubmitSms. It returns the redacted phone number.
+func (f *LoginFlow) SendSms() (phone string, err error) {
+	if !f.Pending(LoginChallengeSms) {
+		return "", ErrLoginChallenge
+	}
+
+	data := struct {
+		Ticket string `json:"ticket"`
+	}{f.ticket}
+	body, err := f.session.RequestWithBucketID("POST", http.EndpointSmsSend, data, http.EndpointSmsSend, f.options...)
+	if err != nil {
+		return
+	}
+
+	var st struct {
+		Phone string `json:"phone"`
+	}
+	err = unmarshal(body, &st)
+	return st.Phone, err
+}
+
+// SubmitSms answers the two-factor challenge with the code sent by SendSms.
+func (f *LoginFlow) SubmitSms(code string) error {
+	return f.submitMfa(LoginChallengeSms, http.EndpointSms, code)
+}
+
+// SubmitBackup answers the two-factor challenge with a backup code.
+func (f *LoginFlow) SubmitBackup(code string) error {
+	return f.submitMfa(LoginChallengeBackup, http.EndpointBackup, code)
+}
+
+func (f *LoginFlow) submitMfa(challenge LoginChallenge, endpoint, code string) error {
+	if !f.Pending(challenge) {
+		return ErrLoginChallenge
+	}
+
+	return f.submit(endpoint, map[string]interface{}{
+		"code":   code,
+		"ticket": f.ticket,
+	})
+}
+
+// submit sends a step of the login, and updates the flow from its response.
+// A wrong answer returns the error and leaves the flow unchanged, so that it
+// can be answered again.
+func (f *LoginFlow) submit(endpoint string, data map[string]interface{}) error {
+	body, err := f.session.RequestWithBucketID("POST", endpoint, data, endpoint, f.options...)
+	if err != nil {
+		var restErr *RESTError
+		if errors.As(err, &restErr) {
+			var captcha CaptchaChallenge
+			if json.Unmarshal(restErr.ResponseBody, &captcha) == nil && captcha.Sitekey != "" {
+				f.endpoint, f.data = endpoint, data
+				f.Challenges = []LoginChallenge{LoginChallengeCaptcha}
+				f.Captcha = &captcha
+				return nil
+			}
+		}
+		return err
+	}
+
+	var lr LoginResponse
+	if err = unmarshal(body, &lr); err != nil {
+		return err
+	}
+
+	f.Challenges, f.Captcha = nil, nil
+
+	if lr.Token != "" {
+		f.Token = lr.Token
+		f.session.Identify.Token = lr.Token
+		return nil
+	}
+
+	if lr.Ticket != "" {
+		f.ticket = lr.Ticket
+	}
+	if lr.Totp {
+		f.Challenges = append(f.Challenges, LoginChallengeTotp)
+	}
+	if lr.Sms {
+		f.Challenges = append(f.Challenges, LoginChallengeSms)
+	}
+	if lr.Backup {
+		f.Challenges = append(f.Challenges, LoginChallengeBackup)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package astatine
+
+import (
+	"encoding/json"
+	netHttp "net/http"
+	"testing"
+)
+
+func TestLoginFlow(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		var data map[string]string
+		json.NewDecoder(r.Body).Decode(&data)
+
+		switch r.URL.Path {
+		case "/auth/login":
+			if data["captcha_key"] != "solved" || data["captcha_rqtoken"] != "rqtoken" {
+				w.WriteHeader(netHttp.StatusBadRequest)
+				w.Write([]byte(`{"captcha_key":["captcha-required"],"captcha_sitekey":"sitekey","captcha_service":"hcaptcha","captcha_rqtoken":"rqtoken"}`))
+				return
+			}
+			if data["login"] != "user@example.com" || data["password"] != "password" {
+				t.Errorf("unexpected login data: %v", data)
+			}
+			w.Write([]byte(`{"user_id":"1","mfa":true,"totp":true,"backup":true,"ticket":"ticket"}`))
+		case "/auth/mfa/totp":
+			if data["code"] != "123456" || data["ticket"] != "ticket" {
+				w.WriteHeader(netHttp.StatusBadRequest)
+				w.Write([]byte(`{"code":60008,"message":"Invalid two-factor code"}`))
+				return
+			}
+			w.Write([]byte(`{"token":"token"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer closeServer()
+
+	f, err := s.LoginFlow("user@example.com", "password")
+	if err != nil {
+		t.Fatalf("LoginFlow returned error: %v", err)
+	}
+	if !f.Pending(LoginChallengeCaptcha) || f.Captcha.Sitekey != "sitekey" {
+		t.Fatalf("login did not ask for a captcha: %+v", f)
+	}
+
+	if err = f.SolveCaptcha("solved"); err != nil {
+		t.Fatalf("SolveCaptcha returned error: %v", err)
+	}
+	if !f.Pending(LoginChallengeTotp) || !f.Pending(LoginChallengeBackup) || f.Pending(LoginChallengeSms) {
+		t.Fatalf("unexpected login challenges: %v", f.Challenges)
+	}
+	if _, err = f.SendSms(); err != ErrLoginChallenge {
+		t.Errorf("SendSms returned %v, want %v", err, ErrLoginChallenge)
+	}
+
+	if err = f.SubmitTotp("000000"); err == nil {
+		t.Fatal("SubmitTotp with a wrong code returned no error")
+	}
+	if err = f.SubmitTotp("123456"); err != nil {
+		t.Fatalf("SubmitTotp returned error: %v", err)
+	}
+	if !f.Done() || s.Identify.Token != "token" {
+		t.Errorf("login is not done, token %q", s.Identify.Token)
+	}
+}
@@ -0,0 +1,547 @@
+// This file contains iterators which page through the results of list
+// endpoints.
+
+package astatine
+
+import (
+	"sort"
+	"time"
+)
+
+// PageDirection is the direction in which an Iterator pages through results.
+type PageDirection int
+
+// Valid PageDirection values
+const (
+	// PageBefore pages from newer to older results.
+	PageBefore PageDirection = iota
+	// PageAfter pages from older to newer results.
+	PageAfter
+)
+
+// pageFetcher fetches up to limit results from cursor, returning the cursor of
+// the next page and whether there may be more results.
+type pageFetcher func(cursor string, limit int) (page []interface{}, next string, more bool, err error)
+
+// An Iterator pages through the results of a list endpoint, requesting a page
+// whenever the previous one was used up. Pages are requested like any other
+// REST request, so they wait on the session's ratelimiter and honor the
+// RequestOptions given when creating the iterator.
+//
+// Each list endpoint returns an iterator embedding an Iterator, such as a
+// MessageIterator, whose Value, ForEach and All methods are typed.
+//
+//	it := s.ChannelMessagesIterator(channelID, PageBefore, "", 500)
+//	for it.Next() {
+//		m := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	fetch    pageFetcher
+	pageSize int
+	limit    int
+
+	cursor string
+	page   []interface{}
+	value  interface{}
+	count  int
+	done   bool
+	err    error
+}
+
+// newIterator returns an Iterator which fetches pages of up to pageSize
+// results, and stops after limit results when limit is not zero.
+func newIterator(fetch pageFetcher, cursor string, pageSize, limit int) *Iterator {
+	return &Iterator{
+		fetch:    fetch,
+		pageSize: pageSize,
+		limit:    limit,
+		cursor:   cursor,
+	}
+}
+
+// Next advances the iterator to the next result, which is then returned by
+// Value. It returns false once there are no more results, or when a request
+// failed, see Err.
+func (it *Iterator) Next() bool {
+	if it.limit > 0 && it.count >= it.limit {
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		limit := it.pageSize
+		if it.limit > 0 && it.limit-it.count < limit {
+			limit = it.limit - it.count
+		}
+
+		var more bool
+		it.page, it.cursor, more, it.err = it.fetch(it.cursor, limit)
+		it.done = !more || len(it.page) == 0
+	}
+
+	it.value, it.page = it.page[0], it.page[1:]
+	it.count++
+	return true
+}
+
+// Value returns the current result.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+// Err returns the error of the request which stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// ForEach calls fn with every remaining result, and stops at the first
+// error returned by fn or by a request.
+func (it *Iterator) ForEach(fn func(interface{}) error) error {
+	for it.Next() {
+		if err := fn(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// A MessageIterator is an Iterator over messages.
+type MessageIterator struct {
+	*Iterator
+}
+
+// Value returns the current message.
+func (it *MessageIterator) Value() *Message {
+	v, _ := it.Iterator.Value().(*Message)
+	return v
+}
+
+// ForEach calls fn with every remaining message, and stops at the first
+// error returned by fn or by a request.
+func (it *MessageIterator) ForEach(fn func(*Message) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*Message))
+	})
+}
+
+// All returns every remaining message.
+func (it *MessageIterator) All() ([]*Message, error) {
+	var all []*Message
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// An UserIterator is an Iterator over users.
+type UserIterator struct {
+	*Iterator
+}
+
+// Value returns the current user.
+func (it *UserIterator) Value() *User {
+	v, _ := it.Iterator.Value().(*User)
+	return v
+}
+
+// ForEach calls fn with every remaining user, and stops at the first
+// error returned by fn or by a request.
+func (it *UserIterator) ForEach(fn func(*User) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*User))
+	})
+}
+
+// All returns every remaining user.
+func (it *UserIterator) All() ([]*User, error) {
+	var all []*User
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// A MemberIterator is an Iterator over members.
+type MemberIterator struct {
+	*Iterator
+}
+
+// Value returns the current member.
+func (it *MemberIterator) Value() *Member {
+	v, _ := it.Iterator.Value().(*Member)
+	return v
+}
+
+// ForEach calls fn with every remaining member, and stops at the first
+// error returned by fn or by a request.
+func (it *MemberIterator) ForEach(fn func(*Member) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*Member))
+	})
+}
+
+// All returns every remaining member.
+func (it *MemberIterator) All() ([]*Member, error) {
+	var all []*Member
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// A GuildBanIterator is an Iterator over bans.
+type GuildBanIterator struct {
+	*Iterator
+}
+
+// Value returns the current ban.
+func (it *GuildBanIterator) Value() *GuildBan {
+	v, _ := it.Iterator.Value().(*GuildBan)
+	return v
+}
+
+// ForEach calls fn with every remaining ban, and stops at the first
+// error returned by fn or by a request.
+func (it *GuildBanIterator) ForEach(fn func(*GuildBan) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*GuildBan))
+	})
+}
+
+// All returns every remaining ban.
+func (it *GuildBanIterator) All() ([]*GuildBan, error) {
+	var all []*GuildBan
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// An AuditLogEntryIterator is an Iterator over audit log entries.
+type AuditLogEntryIterator struct {
+	*Iterator
+}
+
+// Value returns the current audit log entry.
+func (it *AuditLogEntryIterator) Value() *AuditLogEntry {
+	v, _ := it.Iterator.Value().(*AuditLogEntry)
+	return v
+}
+
+// ForEach calls fn with every remaining audit log entry, and stops at the first
+// error returned by fn or by a request.
+func (it *AuditLogEntryIterator) ForEach(fn func(*AuditLogEntry) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*AuditLogEntry))
+	})
+}
+
+// All returns every remaining audit log entry.
+func (it *AuditLogEntryIterator) All() ([]*AuditLogEntry, error) {
+	var all []*AuditLogEntry
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// A GuildScheduledEventUserIterator is an Iterator over scheduled event users.
+type GuildScheduledEventUserIterator struct {
+	*Iterator
+}
+
+// Value returns the current scheduled event user.
+func (it *GuildScheduledEventUserIterator) Value() *GuildScheduledEventUser {
+	v, _ := it.Iterator.Value().(*GuildScheduledEventUser)
+	return v
+}
+
+// ForEach calls fn with every remaining scheduled event user, and stops at the first
+// error returned by fn or by a request.
+func (it *GuildScheduledEventUserIterator) ForEach(fn func(*GuildScheduledEventUser) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*GuildScheduledEventUser))
+	})
+}
+
+// All returns every remaining scheduled event user.
+func (it *GuildScheduledEventUserIterator) All() ([]*GuildScheduledEventUser, error) {
+	var all []*GuildScheduledEventUser
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// A ChannelIterator is an Iterator over channels.
+type ChannelIterator struct {
+	*Iterator
+}
+
+// Value returns the current channel.
+func (it *ChannelIterator) Value() *Channel {
+	v, _ := it.Iterator.Value().(*Channel)
+	return v
+}
+
+// ForEach calls fn with every remaining channel, and stops at the first
+// error returned by fn or by a request.
+func (it *ChannelIterator) ForEach(fn func(*Channel) error) error {
+	return it.Iterator.ForEach(func(v interface{}) error {
+		return fn(v.(*Channel))
+	})
+}
+
+// All returns every remaining channel.
+func (it *ChannelIterator) All() ([]*Channel, error) {
+	var all []*Channel
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// snowflakeLess reports whether the snowflake a is lower than b.
+func snowflakeLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// snowflakePage sorts a page of results in the order of dir, and returns it
+// along with the cursor of the next page and whether it was a full page.
+func snowflakePage(page []interface{}, id func(interface{}) string, dir PageDirection, limit int) ([]interface{}, string, bool) {
+	sort.SliceStable(page, func(i, j int) bool {
+		if dir == PageAfter {
+			return snowflakeLess(id(page[i]), id(page[j]))
+		}
+		return snowflakeLess(id(page[j]), id(page[i]))
+	})
+
+	if len(page) == 0 {
+		return page, "", false
+	}
+	return page, id(page[len(page)-1]), len(page) >= limit
+}
+
+// pageCursors returns the before and after parameters of a request for the
+// page at cursor.
+func pageCursors(dir PageDirection, cursor string) (beforeID, afterID string) {
+	if dir == PageAfter {
+		if cursor == "" {
+			cursor = "0"
+		}
+		return "", cursor
+	}
+	return cursor, ""
+}
+
+// ChannelMessagesIterator returns an iterator over the messages of a channel.
+// channelID : The ID of a Channel.
+// dir       : The direction to page in. PageBefore returns the newest messages first.
+// startID   : If provided, only messages before or after the given ID are returned.
+// limit     : The maximum number of messages to return, zero for all of them.
+func (s *Session) ChannelMessagesIterator(channelID string, dir PageDirection, startID string, limit int, options ...RequestOption) *MessageIterator {
+	return &MessageIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		beforeID, afterID := pageCursors(dir, cursor)
+		st, err := s.ChannelMessages(channelID, limit, beforeID, afterID, "", options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st))
+		for i, v := range st {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*Message).ID }, dir, limit)
+		return page, next, more, nil
+	}, startID, 100, limit)}
+}
+
+// MessageReactionsIterator returns an iterator over the users who reacted to
+// a message with an emoji, in ascending order of user ID. Discord only pages
+// reactions forward.
+// channelID : The channel ID.
+// messageID : The message ID.
+// emojiID   : Either the unicode emoji for the reaction, or a guild emoji identifier.
+// afterID   : If provided, only users after the given ID are returned.
+// limit     : The maximum number of users to return, zero for all of them.
+func (s *Session) MessageReactionsIterator(channelID, messageID, emojiID, afterID string, limit int, options ...RequestOption) *UserIterator {
+	return &UserIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		_, afterID := pageCursors(PageAfter, cursor)
+		st, err := s.MessageReactions(channelID, messageID, emojiID, limit, "", afterID, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st))
+		for i, v := range st {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*User).ID }, PageAfter, limit)
+		return page, next, more, nil
+	}, afterID, 100, limit)}
+}
+
+// GuildMembersIterator returns an iterator over the members of a guild, in
+// ascending order of user ID.
+// guildID : The ID of a Guild.
+// afterID : If provided, only members after the given user ID are returned.
+// limit   : The maximum number of members to return, zero for all of them.
+func (s *Session) GuildMembersIterator(guildID, afterID string, limit int, options ...RequestOption) *MemberIterator {
+	return &MemberIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		st, err := s.GuildMembers(guildID, cursor, limit, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st))
+		for i, v := range st {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*Member).User.ID }, PageAfter, limit)
+		return page, next, more, nil
+	}, afterID, 1000, limit)}
+}
+
+// GuildBansIterator returns an iterator over the bans of a guild.
+// guildID : The ID of a Guild.
+// dir     : The direction to page in.
+// startID : If provided, only bans of users before or after the given ID are returned.
+// limit   : The maximum number of bans to return, zero for all of them.
+func (s *Session) GuildBansIterator(guildID string, dir PageDirection, startID string, limit int, options ...RequestOption) *GuildBanIterator {
+	return &GuildBanIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		beforeID, afterID := pageCursors(dir, cursor)
+		st, err := s.GuildBans(guildID, limit, beforeID, afterID, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st))
+		for i, v := range st {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*GuildBan).User.ID }, dir, limit)
+		return page, next, more, nil
+	}, startID, 1000, limit)}
+}
+
+// GuildAuditLogIterator returns an iterator over the audit log entries of a
+// guild, newest first.
+// guildID    : The ID of a Guild.
+// userID     : If provided the log will be filtered for the given ID.
+// beforeID   : If provided all log entries returned will be before the given ID.
+// actionType : If provided the log will be filtered for the given Action Type.
+// limit      : The maximum number of entries to return, zero for all of them.
+func (s *Session) GuildAuditLogIterator(guildID, userID, beforeID string, actionType, limit int, options ...RequestOption) *AuditLogEntryIterator {
+	return &AuditLogEntryIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		st, err := s.GuildAuditLog(guildID, userID, cursor, actionType, limit, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st.AuditLogEntries))
+		for i, v := range st.AuditLogEntries {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*AuditLogEntry).ID }, PageBefore, limit)
+		return page, next, more, nil
+	}, beforeID, 100, limit)}
+}
+
+// GuildScheduledEventUsersIterator returns an iterator over the users
+// subscribed to a scheduled event.
+// guildID    : The ID of a Guild
+// eventID    : The ID of the event
+// withMember : Whether to include the member object in the response
+// dir        : The direction to page in.
+// startID    : If provided, only users before or after the given ID are returned.
+// limit      : The maximum number of users to return, zero for all of them.
+func (s *Session) GuildScheduledEventUsersIterator(guildID, eventID string, withMember bool, dir PageDirection, startID string, limit int, options ...RequestOption) *GuildScheduledEventUserIterator {
+	return &GuildScheduledEventUserIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		beforeID, afterID := pageCursors(dir, cursor)
+		st, err := s.GuildScheduledEventUsers(guildID, eventID, limit, withMember, beforeID, afterID, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st))
+		for i, v := range st {
+			page[i] = v
+		}
+
+		page, next, more := snowflakePage(page, func(v interface{}) string { return v.(*GuildScheduledEventUser).User.ID }, dir, limit)
+		return page, next, more, nil
+	}, startID, 100, limit)}
+}
+
+// threadsFetcher is the signature of the ThreadsArchived family.
+type threadsFetcher func(channelID string, before *time.Time, limit int, options ...RequestOption) (*ThreadsList, error)
+
+// threadsIterator returns an iterator over archived threads, most recently
+// archived first.
+func threadsIterator(fetch threadsFetcher, channelID string, before *time.Time, limit int, options []RequestOption) *ChannelIterator {
+	var cursor string
+	if before != nil {
+		cursor = before.Format(time.RFC3339Nano)
+	}
+
+	return &ChannelIterator{newIterator(func(cursor string, limit int) ([]interface{}, string, bool, error) {
+		var before *time.Time
+		if cursor != "" {
+			t, err := time.Parse(time.RFC3339Nano, cursor)
+			if err != nil {
+				return nil, "", false, err
+			}
+			before = &t
+		}
+
+		st, err := fetch(channelID, before, limit, options...)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		page := make([]interface{}, len(st.Threads))
+		for i, v := range st.Threads {
+			page[i] = v
+		}
+
+		var next string
+		if n := len(st.Threads); n > 0 && st.Threads[n-1].ThreadMetadata != nil {
+			next = st.Threads[n-1].ThreadMetadata.ArchiveTimestamp.Format(time.RFC3339Nano)
+		}
+		return page, next, st.HasMore && next != "", nil
+	}, cursor, 100, limit)}
+}
+
+// ThreadsArchivedIterator returns an iterator over the archived threads of a
+// channel. See ThreadsArchived.
+func (s *Session) ThreadsArchivedIterator(channelID string, before *time.Time, limit int, options ...RequestOption) *ChannelIterator {
+	return threadsIterator(s.ThreadsArchived, channelID, before, limit, options)
+}
+
+// ThreadsPrivateArchivedIterator returns an iterator over the archived
+// private threads of a channel. See ThreadsPrivateArchived.
+func (s *Session) ThreadsPrivateArchivedIterator(channelID string, before *time.Time, limit int, options ...RequestOption) *ChannelIterator {
+	return threadsIterator(s.ThreadsPrivateArchived, channelID, before, limit, options)
+}
+
+// ThreadsPrivateJoinedArchivedIterator returns an iterator over the archived
+// private threads of a channel which the user joined. See
+// ThreadsPrivateJoinedArchived.
+func (s *Session) ThreadsPrivateJoinedArchivedIterator(channelID string, before *time.Time, limit int, options ...RequestOption) *ChannelIterator {
+	return threadsIterator(s.ThreadsPrivateJoinedArchived, channelID, before, limit, options)
+}
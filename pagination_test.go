@@ -0,0 +1,133 @@
+package astatine
+
+import (
+	"encoding/json"
+	netHttp "net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newMessagesServer returns a session talking to a server which holds a
+// channel with messages 1 to count, paged like Discord does, and a function
+// closing the server.
+func newMessagesServer(t *testing.T, count int) (*Session, *int, func()) {
+	var requests int
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		requests++
+
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		before, _ := strconv.Atoi(q.Get("before"))
+		after, _ := strconv.Atoi(q.Get("after"))
+		if before == 0 {
+			before = count + 1
+		}
+
+		// Discord always returns the newest messages of a page first.
+		var page []*Message
+		from, to := before-1, before-limit
+		if _, ok := q["after"]; ok {
+			from, to = after+limit, after+1
+		}
+		for id := from; id >= to; id-- {
+			if id >= 1 && id <= count && id > after && id < before {
+				page = append(page, &Message{ID: strconv.Itoa(id)})
+			}
+		}
+
+		json.NewEncoder(w).Encode(page)
+	})
+	return s, &requests, closeServer
+}
+
+func TestChannelMessagesIterator(t *testing.T) {
+	tests := []struct {
+		dir      PageDirection
+		startID  string
+		limit    int
+		first    string
+		last     string
+		count    int
+		requests int
+	}{
+		{PageBefore, "", 0, "250", "1", 250, 3},
+		{PageBefore, "", 120, "250", "131", 120, 2},
+		{PageBefore, "101", 0, "100", "1", 100, 2},
+		{PageAfter, "", 0, "1", "250", 250, 3},
+		{PageAfter, "200", 0, "201", "250", 50, 1},
+	}
+
+	for _, tt := range tests {
+		s, requests, closeServer := newMessagesServer(t, 250)
+		defer closeServer()
+
+		messages, err := s.ChannelMessagesIterator("channel", tt.dir, tt.startID, tt.limit).All()
+		if err != nil {
+			t.Fatalf("All returned error: %v", err)
+		}
+
+		if len(messages) != tt.count {
+			t.Fatalf("iterator returned %d messages, want %d", len(messages), tt.count)
+		}
+		if messages[0].ID != tt.first || messages[len(messages)-1].ID != tt.last {
+			t.Errorf("iterator returned messages %s to %s, want %s to %s", messages[0].ID, messages[len(messages)-1].ID, tt.first, tt.last)
+		}
+		if *requests != tt.requests {
+			t.Errorf("iterator made %d requests, want %d", *requests, tt.requests)
+		}
+	}
+}
+
+func TestThreadsArchivedIterator(t *testing.T) {
+	base := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	archived := []time.Time{
+		base.Add(time.Second),
+		// The second and third thread are archived in the same second,
+		// across a page boundary.
+		base.Add(700 * time.Millisecond),
+		base.Add(300 * time.Millisecond),
+		base.Add(-time.Second),
+	}
+
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		before := time.Now()
+		if q := r.URL.Query().Get("before"); q != "" {
+			var err error
+			if before, err = time.Parse(time.RFC3339Nano, q); err != nil {
+				t.Errorf("before is invalid: %v", err)
+				return
+			}
+		}
+
+		// Discord may return fewer threads than the limit, here two.
+		list := &ThreadsList{}
+		for i, ts := range archived {
+			if !ts.Before(before) {
+				continue
+			}
+			if len(list.Threads) == 2 {
+				list.HasMore = true
+				break
+			}
+			list.Threads = append(list.Threads, &Channel{ID: strconv.Itoa(i), ThreadMetadata: &ThreadMetadata{ArchiveTimestamp: ts}})
+		}
+
+		json.NewEncoder(w).Encode(list)
+	})
+	defer closeServer()
+
+	threads, err := s.ThreadsArchivedIterator("channel", nil, 0).All()
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+
+	if len(threads) != len(archived) {
+		t.Fatalf("iterator returned %d threads, want %d", len(threads), len(archived))
+	}
+	for i, thread := range threads {
+		if thread.ID != strconv.Itoa(i) {
+			t.Errorf("thread %d has ID %s, want %d", i, thread.ID, i)
+		}
+	}
+}
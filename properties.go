@@ -0,0 +1,137 @@
+// This file contains presets of the properties which a session identifies
+// with, so that user sessions can identify like an official client.
+
+package astatine
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"runtime"
+)
+
+// Capability is the bitfield of features which a user client supports, sent
+// when identifying. Capabilities change the format of the READY payload.
+type Capability int
+
+// Constants for the different bit offsets of capabilities
+const (
+	CapabilityLazyUserNotes                      Capability = 1 << 0
+	CapabilityNoAffineUserIDs                    Capability = 1 << 1
+	CapabilityVersionedReadStates                Capability = 1 << 2
+	CapabilityVersionedUserGuildSettings         Capability = 1 << 3
+	CapabilityDedupeUserObjects                  Capability = 1 << 4
+	CapabilityPrioritizedReadyPayload            Capability = 1 << 5
+	CapabilityMultipleGuildExperimentPopulations Capability = 1 << 6
+	CapabilityNonChannelReadStates               Capability = 1 << 7
+	CapabilityAuthTokenRefresh                   Capability = 1 << 8
+	CapabilityUserSettingsProto                  Capability = 1 << 9
+	CapabilityClientStateV2                      Capability = 1 << 10
+	CapabilityPassiveGuildUpdate                 Capability = 1 << 11
+)
+
+// ClientState is the cached state which a user client reports when
+// identifying, so that READY only contains what changed.
+type ClientState struct {
+	GuildVersions            map[string]interface{} `json:"guild_versions"`
+	HighestLastMessageID     string                 `json:"highest_last_message_id"`
+	ReadStateVersion         int                    `json:"read_state_version"`
+	UserGuildSettingsVersion int                    `json:"user_guild_settings_version"`
+	UserSettingsVersion      int                    `json:"user_settings_version"`
+	PrivateChannelsVersion   string                 `json:"private_channels_version"`
+	APICodeVersion           int                    `json:"api_code_version"`
+}
+
+// An IdentifyPreset describes a client to identify as, see
+// Session.SetIdentifyPreset.
+type IdentifyPreset struct {
+	Properties   IdentifyProperties
+	Capabilities Capability
+	ClientState  *ClientState
+}
+
+// Known identify presets.
+var (
+	// IdentifyPresetBot is the preset used by New.
+	IdentifyPresetBot = IdentifyPreset{
+		Properties: IdentifyProperties{
+			OS:      runtime.GOOS,
+			Browser: "DiscordGo v" + VERSION,
+		},
+	}
+
+	// IdentifyPresetWeb identifies as the web client in Chrome on
+	// Windows. Its capabilities only include READY formats which State
+	// understands.
+	//
+	// The client build number and Chrome version are those of the
+	// preset's release and go stale as Discord and Chrome update, so
+	// callers should refresh them with WithClientBuild, e.g. from the
+	// build number served by the web client.
+	IdentifyPresetWeb = IdentifyPreset{
+		Properties: IdentifyProperties{
+			OS:                "Windows",
+			Browser:           "Chrome",
+			SystemLocale:      "en-US",
+			BrowserUserAgent:  "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			BrowserVersion:    "120.0.0.0",
+			OSVersion:         "10",
+			ReleaseChannel:    "stable",
+			ClientBuildNumber: 260672,
+		},
+		Capabilities: CapabilityNoAffineUserIDs | CapabilityVersionedReadStates | CapabilityVersionedUserGuildSettings |
+			CapabilityMultipleGuildExperimentPopulations,
+		ClientState: &ClientState{
+			HighestLastMessageID:     "0",
+			UserGuildSettingsVersion: -1,
+			UserSettingsVersion:      -1,
+			PrivateChannelsVersion:   "0",
+		},
+	}
+)
+
+// WithClientBuild returns a copy of the preset with the given client build
+// number, browser user agent and browser version. Empty values keep those
+// of the preset.
+func (p IdentifyPreset) WithClientBuild(buildNumber int, userAgent, browserVersion string) IdentifyPreset {
+	if buildNumber != 0 {
+		p.Properties.ClientBuildNumber = buildNumber
+	}
+	if userAgent != "" {
+		p.Properties.BrowserUserAgent = userAgent
+	}
+	if browserVersion != "" {
+		p.Properties.BrowserVersion = browserVersion
+	}
+
+	return p
+}
+
+// SetIdentifyPreset makes the session identify as the client of a preset.
+// It sets the properties, capabilities and client state of Identify, and
+// the UserAgent of REST requests when the preset has a browser user agent.
+func (s *Session) SetIdentifyPreset(preset IdentifyPreset) {
+	s.Identify.Properties = preset.Properties
+	s.Identify.Capabilities = preset.Capabilities
+	s.Identify.ClientState = nil
+
+	if preset.ClientState != nil {
+		cs := *preset.ClientState
+		cs.GuildVersions = make(map[string]interface{})
+		s.Identify.ClientState = &cs
+	}
+
+	if preset.Properties.BrowserUserAgent != "" {
+		s.UserAgent = preset.Properties.BrowserUserAgent
+	}
+}
+
+// superProperties returns the properties encoded for the X-Super-Properties
+// header.
+func (p *IdentifyProperties) superProperties() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
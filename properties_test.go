@@ -0,0 +1,71 @@
+package astatine
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	netHttp "net/http"
+	"testing"
+)
+
+func TestSetIdentifyPreset(t *testing.T) {
+	var header netHttp.Header
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		header = r.Header
+		w.Write([]byte(`{}`))
+	})
+	defer closeServer()
+	s.Identify.Token = "token"
+
+	if _, err := s.User("@me"); err != nil {
+		t.Fatalf("User returned error: %v", err)
+	}
+	if header.Get("X-Super-Properties") != "" {
+		t.Error("bot preset sent X-Super-Properties")
+	}
+
+	s.SetIdentifyPreset(IdentifyPresetWeb)
+	if _, err := s.User("@me"); err != nil {
+		t.Fatalf("User returned error: %v", err)
+	}
+
+	b, err := base64.StdEncoding.DecodeString(header.Get("X-Super-Properties"))
+	if err != nil {
+		t.Fatalf("DecodeString returned error: %v", err)
+	}
+	var props IdentifyProperties
+	if err = json.Unmarshal(b, &props); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if props != s.Identify.Properties {
+		t.Errorf("X-Super-Properties is %+v, want %+v", props, s.Identify.Properties)
+	}
+	if header.Get("User-Agent") != props.BrowserUserAgent {
+		t.Errorf("User-Agent is %q, want %q", header.Get("User-Agent"), props.BrowserUserAgent)
+	}
+
+	identify, err := json.Marshal(s.Identify)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	json.Unmarshal(identify, &fields)
+	for _, field := range []string{"capabilities", "client_state"} {
+		if _, ok := fields[field]; !ok {
+			t.Errorf("identify payload has no %s", field)
+		}
+	}
+}
+
+func TestIdentifyPresetWithClientBuild(t *testing.T) {
+	p := IdentifyPresetWeb.WithClientBuild(300000, "", "130.0.0.0")
+
+	if p.Properties.ClientBuildNumber != 300000 || p.Properties.BrowserVersion != "130.0.0.0" {
+		t.Errorf("WithClientBuild returned %+v", p.Properties)
+	}
+	if p.Properties.BrowserUserAgent != IdentifyPresetWeb.Properties.BrowserUserAgent {
+		t.Errorf("WithClientBuild changed the user agent to %q", p.Properties.BrowserUserAgent)
+	}
+	if IdentifyPresetWeb.Properties.ClientBuildNumber == 300000 {
+		t.Error("WithClientBuild modified IdentifyPresetWeb")
+	}
+}
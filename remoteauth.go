@@ -0,0 +1,245 @@
+// This file contains the remote authentication client, which logs a user
+// account in by scanning a QR code with a Discord app where the account is
+// already logged in.
+
+package astatine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	netHttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ayntgl/astatine/http"
+	"github.com/gorilla/websocket"
+)
+
+// ErrRemoteAuthCanceled is returned when the user cancels a remote
+// authentication from their app.
+var ErrRemoteAuthCanceled = errors.New("remote authentication was canceled")
+
+// A RemoteAuthUser is the user who scanned the QR code of a remote
+// authentication, before they confirm the login.
+type RemoteAuthUser struct {
+	ID            string
+	Discriminator string
+	Avatar        string
+	Username      string
+}
+
+// A RemoteAuth logs a user account in through the remote authentication
+// gateway. Its Fingerprint handler receives the URL to show as a QR code,
+// which the user scans and confirms with a logged in Discord app.
+type RemoteAuth struct {
+	// The remote authentication gateway, http.EndpointRemoteAuthGateway
+	// when empty.
+	Gateway string
+
+	// The session used to exchange the login ticket for the token. A new
+	// session is used when nil.
+	Session *Session
+
+	// Fingerprint is called with the URL to show as a QR code once the
+	// gateway is ready.
+	Fingerprint func(qrURL string)
+
+	// PendingUser, if set, is called once the QR code was scanned, with the
+	// user who has to confirm the login.
+	PendingUser func(user *RemoteAuthUser)
+}
+
+// remoteAuthMessage is a message of the remote authentication gateway.
+type remoteAuthMessage struct {
+	Op                   string `json:"op"`
+	HeartbeatInterval    int    `json:"heartbeat_interval,omitempty"`
+	EncodedPublicKey     string `json:"encoded_public_key,omitempty"`
+	EncryptedNonce       string `json:"encrypted_nonce,omitempty"`
+	Proof                string `json:"proof,omitempty"`
+	Fingerprint          string `json:"fingerprint,omitempty"`
+	EncryptedUserPayload string `json:"encrypted_user_payload,omitempty"`
+	Ticket               string `json:"ticket,omitempty"`
+	EncryptedToken       string `json:"encrypted_token,omitempty"`
+}
+
+// Login runs the remote authentication until the user confirmed the login,
+// and returns the token of the account, which can be used with New. It
+// returns ErrRemoteAuthCanceled if the user cancels the login, and the
+// context's error once ctx is done.
+func (r *RemoteAuth) Login(ctx context.Context) (token string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return
+	}
+
+	gateway := r.Gateway
+	if gateway == "" {
+		gateway = http.EndpointRemoteAuthGateway
+	}
+
+	header := netHttp.Header{}
+	header.Set("Origin", strings.TrimSuffix(http.EndpointDiscord, "/"))
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, gateway, header)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Closing the connection stops the read loop once ctx is done.
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	var wsMutex sync.Mutex
+	write := func(m remoteAuthMessage) error {
+		wsMutex.Lock()
+		defer wsMutex.Unlock()
+		return conn.WriteJSON(m)
+	}
+
+	for {
+		var m remoteAuthMessage
+		if err = conn.ReadJSON(&m); err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			return
+		}
+
+		switch m.Op {
+		case "hello":
+			go r.heartbeat(write, time.Duration(m.HeartbeatInterval)*time.Millisecond, stop)
+
+			var der []byte
+			der, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+			if err != nil {
+				return
+			}
+			err = write(remoteAuthMessage{Op: "init", EncodedPublicKey: base64.StdEncoding.EncodeToString(der)})
+
+		case "nonce_proof":
+			var nonce []byte
+			nonce, err = remoteAuthDecrypt(key, m.EncryptedNonce)
+			if err != nil {
+				return
+			}
+			proof := sha256.Sum256(nonce)
+			err = write(remoteAuthMessage{Op: "nonce_proof", Proof: base64.RawURLEncoding.EncodeToString(proof[:])})
+
+		case "pending_remote_init":
+			if r.Fingerprint != nil {
+				r.Fingerprint(http.EndpointRemoteAuthQR(m.Fingerprint))
+			}
+
+		case "pending_ticket":
+			var payload []byte
+			payload, err = remoteAuthDecrypt(key, m.EncryptedUserPayload)
+			if err != nil {
+				return
+			}
+
+			// The payload is "id:discriminator:avatar:username".
+			fields := strings.SplitN(string(payload), ":", 4)
+			if len(fields) != 4 {
+				return "", fmt.Errorf("invalid remote authentication user payload %q", payload)
+			}
+			if r.PendingUser != nil {
+				r.PendingUser(&RemoteAuthUser{
+					ID:            fields[0],
+					Discriminator: fields[1],
+					Avatar:        fields[2],
+					Username:      fields[3],
+				})
+			}
+
+		case "pending_login":
+			return r.exchangeTicket(ctx, key, m.Ticket)
+
+		case "finish":
+			var t []byte
+			t, err = remoteAuthDecrypt(key, m.EncryptedToken)
+			return string(t), err
+
+		case "cancel":
+			return "", ErrRemoteAuthCanceled
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// heartbeat sends a heartbeat every interval until stop is closed.
+func (r *RemoteAuth) heartbeat(write func(remoteAuthMessage) error, interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if write(remoteAuthMessage{Op: "heartbeat"}) != nil {
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// exchangeTicket exchanges the ticket of a confirmed login for the token of
+// the account.
+func (r *RemoteAuth) exchangeTicket(ctx context.Context, key *rsa.PrivateKey, ticket string) (token string, err error) {
+	s := r.Session
+	if s == nil {
+		s = New("")
+	}
+
+	data := struct {
+		Ticket string `json:"ticket"`
+	}{ticket}
+	body, err := s.RequestWithBucketID("POST", http.EndpointRemoteAuthLogin, data, http.EndpointRemoteAuthLogin, WithContext(ctx))
+	if err != nil {
+		return
+	}
+
+	var st struct {
+		EncryptedToken string `json:"encrypted_token"`
+	}
+	if err = unmarshal(body, &st); err != nil {
+		return
+	}
+
+	t, err := remoteAuthDecrypt(key, st.EncryptedToken)
+	return string(t), err
+}
+
+// remoteAuthDecrypt decrypts a base64 encoded message of the remote
+// authentication gateway.
+func remoteAuthDecrypt(key *rsa.PrivateKey, encrypted string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsa.DecryptOAEP(sha256.New(), nil, key, b, nil)
+}
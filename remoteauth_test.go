@@ -0,0 +1,113 @@
+package astatine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	netHttp "net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ayntgl/astatine/http"
+	"github.com/gorilla/websocket"
+)
+
+func TestRemoteAuthLogin(t *testing.T) {
+	var (
+		pubMu sync.Mutex
+		pub   *rsa.PublicKey
+	)
+
+	encrypt := func(b []byte) string {
+		pubMu.Lock()
+		defer pubMu.Unlock()
+
+		e, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, b, nil)
+		if err != nil {
+			t.Errorf("EncryptOAEP returned error: %v", err)
+		}
+		return base64.StdEncoding.EncodeToString(e)
+	}
+
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path == "/users/@me/remote-auth/login" {
+			var data map[string]string
+			json.NewDecoder(r.Body).Decode(&data)
+			if data["ticket"] != "ticket" {
+				t.Errorf("ticket is %q, want %q", data["ticket"], "ticket")
+			}
+			json.NewEncoder(w).Encode(map[string]string{"encrypted_token": encrypt([]byte("token"))})
+			return
+		}
+
+		if r.Header.Get("Origin") != "https://discord.com" {
+			t.Errorf("Origin is %q, want %q", r.Header.Get("Origin"), "https://discord.com")
+		}
+
+		upgrader := websocket.Upgrader{CheckOrigin: func(*netHttp.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade returned error: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(remoteAuthMessage{Op: "hello", HeartbeatInterval: 41250})
+
+		var m remoteAuthMessage
+		conn.ReadJSON(&m)
+		der, _ := base64.StdEncoding.DecodeString(m.EncodedPublicKey)
+		key, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			t.Errorf("ParsePKIXPublicKey returned error: %v", err)
+			return
+		}
+		pubMu.Lock()
+		pub = key.(*rsa.PublicKey)
+		pubMu.Unlock()
+
+		nonce := []byte("nonce")
+		conn.WriteJSON(remoteAuthMessage{Op: "nonce_proof", EncryptedNonce: encrypt(nonce)})
+		conn.ReadJSON(&m)
+		proof := sha256.Sum256(nonce)
+		if m.Proof != base64.RawURLEncoding.EncodeToString(proof[:]) {
+			t.Errorf("invalid nonce proof %q", m.Proof)
+		}
+
+		conn.WriteJSON(remoteAuthMessage{Op: "pending_remote_init", Fingerprint: "fingerprint"})
+		conn.WriteJSON(remoteAuthMessage{Op: "pending_ticket", EncryptedUserPayload: encrypt([]byte("1:0001:avatar:user:name"))})
+		conn.WriteJSON(remoteAuthMessage{Op: "pending_login", Ticket: "ticket"})
+		conn.ReadJSON(&m)
+	})
+	defer closeServer()
+
+	var (
+		qrURL string
+		user  *RemoteAuthUser
+	)
+	ra := &RemoteAuth{
+		Gateway:     "ws" + strings.TrimPrefix(strings.TrimSuffix(s.APIBase, "/"), "http"),
+		Session:     s,
+		Fingerprint: func(u string) { qrURL = u },
+		PendingUser: func(u *RemoteAuthUser) { user = u },
+	}
+
+	token, err := ra.Login(context.Background())
+	if err != nil {
+		t.Fatalf("Login returned error: %v", err)
+	}
+	if token != "token" {
+		t.Errorf("token is %q, want %q", token, "token")
+	}
+	if qrURL != http.EndpointRemoteAuthQR("fingerprint") {
+		t.Errorf("QR code URL is %q", qrURL)
+	}
+	if user == nil || user.ID != "1" || user.Username != "user:name" {
+		t.Errorf("unexpected pending user %+v", user)
+	}
+}
@@ -12,6 +12,7 @@ package astatine
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,6 +40,9 @@ var (
 	ErrPruneDaysBounds         = errors.New("the number of days should be more than or equal to 1")
 	ErrGuildNoIcon             = errors.New("guild does not have an icon set")
 	ErrGuildNoSplash           = errors.New("guild does not have a splash set")
+	ErrStickerNoFile           = errors.New("sticker file is missing")
+	ErrStickerNoParams         = errors.New("sticker params are missing")
+	ErrForumPostNoMessage      = errors.New("forum post message is missing")
 	ErrUnauthorized            = errors.New("HTTP request was unauthorized. This could be because the provided token was not a bot token. Please add \"Bot \" to the start of your token. https://discord.com/developers/docs/reference#authentication-example-bot-token-authorization-header")
 )
 
@@ -76,13 +80,121 @@ func (r RESTError) Error() string {
 	return "HTTP " + r.Response.Status + ", " + string(r.ResponseBody)
 }
 
+// Unwrap returns the ErrorCode of the error, or nil if Discord didn't return
+// one, so that REST errors can be matched with errors.Is and errors.As.
+func (r RESTError) Unwrap() error {
+	if r.Message == nil || r.Message.Code == ErrCodeGeneralError {
+		return nil
+	}
+	return ErrorCode(r.Message.Code)
+}
+
+// An ErrorCode is a Discord JSON error code, see the ErrCode constants.
+// REST errors unwrap to their ErrorCode:
+//
+//	if errors.Is(err, astatine.ErrUnknownMessage) {
+//		...
+//	}
+type ErrorCode int
+
+// Error returns the error code as an error message.
+func (c ErrorCode) Error() string {
+	return "Discord error code " + strconv.Itoa(int(c))
+}
+
+// Common errors returned by REST requests
+const (
+	ErrUnknownAccount                 ErrorCode = ErrCodeUnknownAccount
+	ErrUnknownApplication             ErrorCode = ErrCodeUnknownApplication
+	ErrUnknownChannel                 ErrorCode = ErrCodeUnknownChannel
+	ErrUnknownGuild                   ErrorCode = ErrCodeUnknownGuild
+	ErrUnknownIntegration             ErrorCode = ErrCodeUnknownIntegration
+	ErrUnknownInvite                  ErrorCode = ErrCodeUnknownInvite
+	ErrUnknownMember                  ErrorCode = ErrCodeUnknownMember
+	ErrUnknownMessage                 ErrorCode = ErrCodeUnknownMessage
+	ErrUnknownOverwrite               ErrorCode = ErrCodeUnknownOverwrite
+	ErrUnknownRole                    ErrorCode = ErrCodeUnknownRole
+	ErrUnknownToken                   ErrorCode = ErrCodeUnknownToken
+	ErrUnknownUser                    ErrorCode = ErrCodeUnknownUser
+	ErrUnknownEmoji                   ErrorCode = ErrCodeUnknownEmoji
+	ErrUnknownWebhook                 ErrorCode = ErrCodeUnknownWebhook
+	ErrUnknownBan                     ErrorCode = ErrCodeUnknownBan
+	ErrUnknownSticker                 ErrorCode = ErrCodeUnknownSticker
+	ErrUnknownInteraction             ErrorCode = ErrCodeUnknownInteraction
+	ErrUnknownApplicationCommand      ErrorCode = ErrCodeUnknownApplicationCommand
+	ErrUnknownStageInstance           ErrorCode = ErrCodeUnknownStageInstance
+	ErrUnknownGuildScheduledEvent     ErrorCode = ErrCodeUnknownGuildScheduledEvent
+	ErrMissingAccess                  ErrorCode = ErrCodeMissingAccess
+	ErrMissingPermissions             ErrorCode = ErrCodeMissingPermissions
+	ErrInvalidAuthenticationToken     ErrorCode = ErrCodeInvalidAuthenticationToken
+	ErrCannotSendMessagesToThisUser   ErrorCode = ErrCodeCannotSendMessagesToThisUser
+	ErrInvalidFormBody                ErrorCode = ErrCodeInvalidFormBody
+	ErrInteractionAlreadyAcknowledged ErrorCode = ErrCodeInteractionHasAlreadyBeenAcknowledged
+	ErrThreadIsLocked                 ErrorCode = ErrCodeThreadIsLocked
+	ErrTwoFactorRequired              ErrorCode = ErrCodeTwoFactorRequired
+	ErrInvalidTwoFactorCode           ErrorCode = ErrCodeInvalidTwoFactorCode
+)
+
+// RequestConfig holds the settings of a single REST request.
+type RequestConfig struct {
+	// Context bounds the whole request, including ratelimit bucket waits,
+	// retries and the HTTP round trip.
+	Context context.Context
+
+	// RetryPolicy replaces the retry policy of the session for the request.
+	RetryPolicy *RetryPolicy
+
+	// The time of the first attempt of the request.
+	start time.Time
+}
+
+// RequestOption is a function which modifies the configuration of a request.
+type RequestOption func(cfg *RequestConfig)
+
+// WithContext sets the context of the request. Once ctx is done, waiting on
+// ratelimits is abandoned and the in-flight HTTP request is cancelled.
+func WithContext(ctx context.Context) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.Context = ctx
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the request, e.g. to retry a POST
+// request which is safe to send twice.
+func WithRetryPolicy(policy RetryPolicy) RequestOption {
+	policy = policy.clone()
+	return func(cfg *RequestConfig) {
+		cfg.RetryPolicy = &policy
+	}
+}
+
+// withStart sets the time of the first attempt of a retried request.
+func withStart(start time.Time) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.start = start
+	}
+}
+
+// newRequestConfig returns the configuration for a request with all options applied.
+func newRequestConfig(options []RequestOption) *RequestConfig {
+	cfg := &RequestConfig{
+		Context: context.Background(),
+	}
+
+	for _, opt := range options {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
 // Request is the same as RequestWithBucketID but the bucket id is the same as the urlStr
-func (s *Session) Request(method, urlStr string, data interface{}) (response []byte, err error) {
-	return s.RequestWithBucketID(method, urlStr, data, strings.SplitN(urlStr, "?", 2)[0])
+func (s *Session) Request(method, urlStr string, data interface{}, options ...RequestOption) (response []byte, err error) {
+	return s.RequestWithBucketID(method, urlStr, data, strings.SplitN(urlStr, "?", 2)[0], options...)
 }
 
 // RequestWithBucketID makes a (GET/POST/...) Requests to Discord REST API with JSON data.
-func (s *Session) RequestWithBucketID(method, urlStr string, data interface{}, bucketID string) (response []byte, err error) {
+func (s *Session) RequestWithBucketID(method, urlStr string, data interface{}, bucketID string, options ...RequestOption) (response []byte, err error) {
 	var body []byte
 	if data != nil {
 		body, err = json.Marshal(data)
@@ -91,29 +203,53 @@ func (s *Session) RequestWithBucketID(method, urlStr string, data interface{}, b
 		}
 	}
 
-	return s.request(method, urlStr, "application/json", body, bucketID, 0)
+	return s.request(method, urlStr, "application/json", body, bucketID, 0, options...)
 }
 
 // request makes a (GET/POST/...) Requests to Discord REST API.
-// Sequence is the sequence number, if it fails with a 502 it will
-// retry with sequence+1 until it either succeeds or sequence >= session.MaxRestRetries
-func (s *Session) request(method, urlStr, contentType string, b []byte, bucketID string, sequence int) (response []byte, err error) {
+// Sequence is the sequence number, if it fails and the RetryPolicy allows it
+// will retry with sequence+1 until it either succeeds or sequence >= session.MaxRestRetries
+func (s *Session) request(method, urlStr, contentType string, b []byte, bucketID string, sequence int, options ...RequestOption) (response []byte, err error) {
 	if bucketID == "" {
 		bucketID = strings.SplitN(urlStr, "?", 2)[0]
 	}
-	return s.RequestWithLockedBucket(method, urlStr, contentType, b, s.Ratelimiter.LockBucket(bucketID), sequence)
+
+	cfg := newRequestConfig(options)
+	bucket, err := s.Ratelimiter.LockBucketContext(cfg.Context, bucketID)
+	if err != nil {
+		return
+	}
+
+	return s.RequestWithLockedBucket(method, urlStr, contentType, b, bucket, sequence, options...)
+}
+
+// rebaseURL replaces the default API or CDN base of urlStr with the APIBase
+// or CDNBase of the session.
+func (s *Session) rebaseURL(urlStr string) string {
+	if s.APIBase != "" && strings.HasPrefix(urlStr, http.EndpointAPI) {
+		return s.APIBase + strings.TrimPrefix(urlStr, http.EndpointAPI)
+	}
+	if s.CDNBase != "" && strings.HasPrefix(urlStr, http.EndpointCDN) {
+		return s.CDNBase + strings.TrimPrefix(urlStr, http.EndpointCDN)
+	}
+	return urlStr
 }
 
 // RequestWithLockedBucket makes a request using a bucket that's already been locked
-func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b []byte, bucket *http.Bucket, sequence int) (response []byte, err error) {
+func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b []byte, bucket *http.Bucket, sequence int, options ...RequestOption) (response []byte, err error) {
+	cfg := newRequestConfig(options)
+	if cfg.start.IsZero() {
+		cfg.start = time.Now()
+	}
+
 	if s.Debug {
 		log.Printf("API REQUEST %8s :: %s\n", method, urlStr)
 		log.Printf("API REQUEST  PAYLOAD :: [%s]\n", string(b))
 	}
 
-	req, err := netHttp.NewRequest(method, urlStr, bytes.NewBuffer(b))
+	req, err := netHttp.NewRequestWithContext(cfg.Context, method, s.rebaseURL(urlStr), bytes.NewBuffer(b))
 	if err != nil {
-		bucket.Release(nil)
+		s.Ratelimiter.ReleaseBucket(bucket, nil)
 		return
 	}
 
@@ -132,6 +268,20 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 	// TODO: Make a configurable static variable.
 	req.Header.Set("User-Agent", s.UserAgent)
 
+	// User clients describe themselves on every request, like they do when
+	// identifying.
+	if props := s.Identify.Properties; props.ClientBuildNumber != 0 {
+		superProperties, err := props.superProperties()
+		if err != nil {
+			s.Ratelimiter.ReleaseBucket(bucket, nil)
+			return nil, err
+		}
+		req.Header.Set("X-Super-Properties", superProperties)
+		if props.SystemLocale != "" {
+			req.Header.Set("X-Discord-Locale", props.SystemLocale)
+		}
+	}
+
 	if s.Debug {
 		for k, v := range req.Header {
 			log.Printf("API REQUEST   HEADER :: [%s] = %+v\n", k, v)
@@ -140,7 +290,10 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 
 	resp, err := s.Client.Do(req)
 	if err != nil {
-		bucket.Release(nil)
+		s.Ratelimiter.ReleaseBucket(bucket, nil)
+		if delay, ok := s.retryDelay(cfg, method, sequence, 0); ok {
+			response, err = s.retryRequest(cfg, method, urlStr, contentType, b, bucket, sequence, options, delay, 0, err)
+		}
 		return
 	}
 	defer func() {
@@ -150,7 +303,7 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 		}
 	}()
 
-	err = bucket.Release(resp.Header)
+	err = s.Ratelimiter.ReleaseBucket(bucket, resp.Header)
 	if err != nil {
 		return
 	}
@@ -173,15 +326,6 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 	case netHttp.StatusOK:
 	case netHttp.StatusCreated:
 	case netHttp.StatusNoContent:
-	case netHttp.StatusBadGateway:
-		// Retry sending request if possible
-		if sequence < s.MaxRestRetries {
-
-			s.log(LogInformational, "%s Failed (%s), Retrying...", urlStr, resp.Status)
-			response, err = s.RequestWithLockedBucket(method, urlStr, contentType, b, s.Ratelimiter.LockBucketObject(bucket), sequence+1)
-		} else {
-			err = fmt.Errorf("Exceeded Max retries HTTP %s, %s", resp.Status, response)
-		}
 	case 429: // TOO MANY REQUESTS - Rate limiting
 		rl := TooManyRequests{}
 		err = json.Unmarshal(response, &rl)
@@ -192,11 +336,18 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 		s.log(LogInformational, "Rate Limiting %s, retry in %v", urlStr, rl.RetryAfter)
 		s.handleEvent(rateLimitEventType, &RateLimit{TooManyRequests: &rl, URL: urlStr})
 
-		time.Sleep(rl.RetryAfter)
+		err = sleepContext(cfg.Context, rl.RetryAfter)
+		if err != nil {
+			return
+		}
 		// we can make the above smarter
 		// this method can cause longer delays than required
 
-		response, err = s.RequestWithLockedBucket(method, urlStr, contentType, b, s.Ratelimiter.LockBucketObject(bucket), sequence)
+		bucket, err = s.Ratelimiter.LockBucketObjectContext(cfg.Context, bucket)
+		if err != nil {
+			return
+		}
+		response, err = s.RequestWithLockedBucket(method, urlStr, contentType, b, bucket, sequence, options...)
 	case netHttp.StatusUnauthorized:
 		if strings.Index(s.Identify.Token, "Bot ") != 0 {
 			s.log(LogInformational, ErrUnauthorized.Error())
@@ -205,11 +356,79 @@ func (s *Session) RequestWithLockedBucket(method, urlStr, contentType string, b
 		fallthrough
 	default: // Error condition
 		err = newRestError(req, resp, response)
+
+		// Retry sending request if possible
+		if delay, ok := s.retryDelay(cfg, method, sequence, resp.StatusCode); ok {
+			response, err = s.retryRequest(cfg, method, urlStr, contentType, b, bucket, sequence, options, delay, resp.StatusCode, err)
+		}
 	}
 
 	return
 }
 
+// retryDelay returns the wait before sending a request again which failed
+// with statusCode, or without a response when statusCode is 0, and whether
+// the retry policy allows to send it again.
+func (s *Session) retryDelay(cfg *RequestConfig, method string, sequence, statusCode int) (delay time.Duration, ok bool) {
+	policy := &s.RetryPolicy
+	if cfg.RetryPolicy != nil {
+		policy = cfg.RetryPolicy
+	} else if policy.isZero() {
+		policy = &DefaultRetryPolicy
+	}
+
+	if sequence >= s.MaxRestRetries || cfg.Context.Err() != nil || !policy.retryable(method, statusCode) {
+		return
+	}
+
+	delay = policy.backoff(sequence)
+	if policy.MaxElapsed > 0 && time.Since(cfg.start)+delay > policy.MaxElapsed {
+		return
+	}
+
+	return delay, true
+}
+
+// retryRequest sends a failed request again after waiting for delay.
+func (s *Session) retryRequest(cfg *RequestConfig, method, urlStr, contentType string, b []byte, bucket *http.Bucket, sequence int, options []RequestOption, delay time.Duration, statusCode int, cause error) (response []byte, err error) {
+	s.log(LogInformational, "%s Failed (%s), Retrying in %v...", urlStr, cause, delay)
+	s.handleEvent(requestRetryEventType, &RequestRetry{
+		Method:     method,
+		URL:        urlStr,
+		Attempt:    sequence + 1,
+		StatusCode: statusCode,
+		Err:        cause,
+		Delay:      delay,
+	})
+
+	err = sleepContext(cfg.Context, delay)
+	if err != nil {
+		return
+	}
+
+	bucket, err = s.Ratelimiter.LockBucketObjectContext(cfg.Context, bucket)
+	if err != nil {
+		return
+	}
+
+	options = append(options[:len(options):len(options)], withStart(cfg.start))
+	return s.RequestWithLockedBucket(method, urlStr, contentType, b, bucket, sequence+1, options...)
+}
+
+// sleepContext pauses for the duration d, returning early with the context's
+// error if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func unmarshal(data []byte, v interface{}) error {
 	err := json.Unmarshal(data, v)
 	if err != nil {
@@ -225,9 +444,9 @@ func unmarshal(data []byte, v interface{}) error {
 
 // User returns the user details of the given userID
 // userID    : A user ID or "@me" which is a shortcut of current user ID
-func (s *Session) User(userID string) (st *User, err error) {
+func (s *Session) User(userID string, options ...RequestOption) (st *User, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointUser(userID), nil, http.EndpointUsers)
+	body, err := s.RequestWithBucketID("GET", http.EndpointUser(userID), nil, http.EndpointUsers, options...)
 	if err != nil {
 		return
 	}
@@ -238,19 +457,19 @@ func (s *Session) User(userID string) (st *User, err error) {
 
 // UserAvatar is deprecated. Please use UserAvatarDecode
 // userID    : A user ID or "@me" which is a shortcut of current user ID
-func (s *Session) UserAvatar(userID string) (img image.Image, err error) {
-	u, err := s.User(userID)
+func (s *Session) UserAvatar(userID string, options ...RequestOption) (img image.Image, err error) {
+	u, err := s.User(userID, options...)
 	if err != nil {
 		return
 	}
-	img, err = s.UserAvatarDecode(u)
+	img, err = s.UserAvatarDecode(u, options...)
 	return
 }
 
 // UserAvatarDecode returns an image.Image of a user's Avatar
 // user : The user which avatar should be retrieved
-func (s *Session) UserAvatarDecode(u *User) (img image.Image, err error) {
-	body, err := s.RequestWithBucketID("GET", http.EndpointUserAvatar(u.ID, u.Avatar), nil, http.EndpointUserAvatar("", ""))
+func (s *Session) UserAvatarDecode(u *User, options ...RequestOption) (img image.Image, err error) {
+	body, err := s.RequestWithBucketID("GET", http.EndpointUserAvatar(u.ID, u.Avatar), nil, http.EndpointUserAvatar("", ""), options...)
 	if err != nil {
 		return
 	}
@@ -260,7 +479,7 @@ func (s *Session) UserAvatarDecode(u *User) (img image.Image, err error) {
 }
 
 // UserUpdate updates current user settings.
-func (s *Session) UserUpdate(username, avatar string) (st *User, err error) {
+func (s *Session) UserUpdate(username, avatar string, options ...RequestOption) (st *User, err error) {
 
 	// NOTE: Avatar must be either the hash/id of existing Avatar or
 	// data:image/png;base64,BASE64_STRING_OF_NEW_AVATAR_PNG
@@ -272,7 +491,7 @@ func (s *Session) UserUpdate(username, avatar string) (st *User, err error) {
 		Avatar   string `json:"avatar,omitempty"`
 	}{username, avatar}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointUser("@me"), data, http.EndpointUsers)
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointUser("@me"), data, http.EndpointUsers, options...)
 	if err != nil {
 		return
 	}
@@ -282,8 +501,8 @@ func (s *Session) UserUpdate(username, avatar string) (st *User, err error) {
 }
 
 // UserConnections returns the user's connections
-func (s *Session) UserConnections() (conn []*UserConnection, err error) {
-	response, err := s.RequestWithBucketID("GET", http.EndpointUserConnections("@me"), nil, http.EndpointUserConnections("@me"))
+func (s *Session) UserConnections(options ...RequestOption) (conn []*UserConnection, err error) {
+	response, err := s.RequestWithBucketID("GET", http.EndpointUserConnections("@me"), nil, http.EndpointUserConnections("@me"), options...)
 	if err != nil {
 		return nil, err
 	}
@@ -296,15 +515,85 @@ func (s *Session) UserConnections() (conn []*UserConnection, err error) {
 	return
 }
 
+// UserSettings returns the settings of the current user.
+func (s *Session) UserSettings(options ...RequestOption) (st *Settings, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointUserSettings("@me"), nil, http.EndpointUserSettings(""), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// UserSettingsEdit changes the settings of the current user, and returns
+// them.
+// settings : The settings to change, unset fields are left unchanged.
+func (s *Session) UserSettingsEdit(settings *SettingsEdit, options ...RequestOption) (st *Settings, err error) {
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointUserSettings("@me"), settings, http.EndpointUserSettings(""), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// UserGuildSettingsEdit changes the notification settings of the current
+// user in a guild, and returns them.
+// guildID  : The ID of a Guild, or "@me" for direct messages.
+// settings : The guild settings. Every field is sent, so copy the current settings from State.GuildSettings first.
+func (s *Session) UserGuildSettingsEdit(guildID string, settings *UserGuildSettingsEdit, options ...RequestOption) (st *UserGuildSettings, err error) {
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointUserGuildSettings("@me", guildID), settings, http.EndpointUserGuildSettings("", guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// UserNote returns the note of the current user on a user.
+// userID    : ID of the user.
+func (s *Session) UserNote(userID string, options ...RequestOption) (note string, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointUserNotes(userID), nil, http.EndpointUserNotes(""), options...)
+	if err != nil {
+		return
+	}
+
+	var st struct {
+		Note string `json:"note"`
+	}
+	err = unmarshal(body, &st)
+	return st.Note, err
+}
+
+// UserNoteSet sets the note of the current user on a user.
+// userID    : ID of the user.
+// note      : The note, or an empty string to remove it.
+func (s *Session) UserNoteSet(userID, note string, options ...RequestOption) (err error) {
+
+	data := struct {
+		Note string `json:"note"`
+	}{note}
+
+	_, err = s.RequestWithBucketID("PUT", http.EndpointUserNotes(userID), data, http.EndpointUserNotes(""), options...)
+	return
+}
+
 // UserChannelCreate creates a new User (Private) Channel with another User
 // recipientID : A user ID for the user to which this channel is opened with.
-func (s *Session) UserChannelCreate(recipientID string) (st *Channel, err error) {
+func (s *Session) UserChannelCreate(recipientID string, options ...RequestOption) (st *Channel, err error) {
 
 	data := struct {
 		RecipientID string `json:"recipient_id"`
 	}{recipientID}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointUserChannels("@me"), data, http.EndpointUserChannels(""))
+	body, err := s.RequestWithBucketID("POST", http.EndpointUserChannels("@me"), data, http.EndpointUserChannels(""), options...)
 	if err != nil {
 		return
 	}
@@ -317,7 +606,7 @@ func (s *Session) UserChannelCreate(recipientID string) (st *Channel, err error)
 // limit     : The number guilds that can be returned. (max 100)
 // beforeID  : If provided all guilds returned will be before given ID.
 // afterID   : If provided all guilds returned will be after given ID.
-func (s *Session) UserGuilds(limit int, beforeID, afterID string) (st []*UserGuild, err error) {
+func (s *Session) UserGuilds(limit int, beforeID, afterID string, options ...RequestOption) (st []*UserGuild, err error) {
 
 	v := url.Values{}
 
@@ -337,7 +626,7 @@ func (s *Session) UserGuilds(limit int, beforeID, afterID string) (st []*UserGui
 		uri += "?" + v.Encode()
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointUserGuilds(""))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointUserGuilds(""), options...)
 	if err != nil {
 		return
 	}
@@ -352,7 +641,7 @@ func (s *Session) UserGuilds(limit int, beforeID, afterID string) (st []*UserGui
 //
 // NOTE: This function is now deprecated and will be removed in the future.
 // Please see the same function inside state.go
-func (s *Session) UserChannelPermissions(userID, channelID string) (apermissions int64, err error) {
+func (s *Session) UserChannelPermissions(userID, channelID string, options ...RequestOption) (apermissions int64, err error) {
 	// Try to just get permissions from state.
 	apermissions, err = s.State.UserChannelPermissions(userID, channelID)
 	if err == nil {
@@ -362,7 +651,7 @@ func (s *Session) UserChannelPermissions(userID, channelID string) (apermissions
 	// Otherwise try get as much data from state as possible, falling back to the network.
 	channel, err := s.State.Channel(channelID)
 	if err != nil || channel == nil {
-		channel, err = s.Channel(channelID)
+		channel, err = s.Channel(channelID, options...)
 		if err != nil {
 			return
 		}
@@ -370,7 +659,7 @@ func (s *Session) UserChannelPermissions(userID, channelID string) (apermissions
 
 	guild, err := s.State.Guild(channel.GuildID)
 	if err != nil || guild == nil {
-		guild, err = s.Guild(channel.GuildID)
+		guild, err = s.Guild(channel.GuildID, options...)
 		if err != nil {
 			return
 		}
@@ -383,7 +672,7 @@ func (s *Session) UserChannelPermissions(userID, channelID string) (apermissions
 
 	member, err := s.State.Member(guild.ID, userID)
 	if err != nil || member == nil {
-		member, err = s.GuildMember(guild.ID, userID)
+		member, err = s.GuildMember(guild.ID, userID, options...)
 		if err != nil {
 			return
 		}
@@ -459,14 +748,107 @@ func memberPermissions(guild *Guild, channel *Channel, userID string, roles []st
 	return apermissions
 }
 
+// ------------------------------------------------------------------------------------------------
+// Functions specific to relationships of user accounts
+// ------------------------------------------------------------------------------------------------
+
+// Relationships returns the relationships of the current user: friends,
+// blocked users and pending friend requests.
+func (s *Session) Relationships(options ...RequestOption) (st []*Relationship, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointRelationships, nil, http.EndpointRelationships, options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// RelationshipsMutual returns the friends which the current user has in
+// common with another user.
+// userID    : ID of the user.
+func (s *Session) RelationshipsMutual(userID string, options ...RequestOption) (st []*User, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointRelationshipsMutual(userID), nil, http.EndpointRelationshipsMutual(""), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// relationshipCreate creates a relationship with a user, or changes its type.
+// userID    : ID of the user.
+// data      : The type of the relationship, if any.
+func (s *Session) relationshipCreate(userID string, data interface{}, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("PUT", http.EndpointRelationship(userID), data, http.EndpointRelationship(""), options...)
+	return
+}
+
+// RelationshipFriendRequestSend sends a friend request to a user.
+// userID    : ID of the user.
+func (s *Session) RelationshipFriendRequestSend(userID string, options ...RequestOption) (err error) {
+	return s.relationshipCreate(userID, struct{}{}, options...)
+}
+
+// RelationshipFriendRequestSendByUsername sends a friend request to a user by
+// their username.
+// username      : The username of the user.
+// discriminator : The discriminator of the user, empty or "0" if they have none.
+func (s *Session) RelationshipFriendRequestSendByUsername(username, discriminator string, options ...RequestOption) (err error) {
+
+	data := struct {
+		Username      string `json:"username"`
+		Discriminator *int   `json:"discriminator"`
+	}{Username: username}
+
+	if d, _ := strconv.Atoi(discriminator); d != 0 {
+		data.Discriminator = &d
+	}
+
+	_, err = s.RequestWithBucketID("POST", http.EndpointRelationships, data, http.EndpointRelationships, options...)
+	return
+}
+
+// RelationshipFriendRequestAccept accepts a friend request from a user.
+// Discord accepts a pending request with the same request which sends one,
+// so it is an alias of RelationshipFriendRequestSend.
+// userID    : ID of the user.
+func (s *Session) RelationshipFriendRequestAccept(userID string, options ...RequestOption) (err error) {
+	return s.RelationshipFriendRequestSend(userID, options...)
+}
+
+// RelationshipUserBlock blocks a user.
+// userID    : ID of the user.
+func (s *Session) RelationshipUserBlock(userID string, options ...RequestOption) (err error) {
+
+	data := struct {
+		Type RelationshipType `json:"type"`
+	}{RelationshipTypeBlocked}
+
+	return s.relationshipCreate(userID, data, options...)
+}
+
+// RelationshipDelete removes the relationship with a user: it removes a
+// friend, unblocks a user, or cancels or declines a friend request.
+// userID    : ID of the user.
+func (s *Session) RelationshipDelete(userID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointRelationship(userID), nil, http.EndpointRelationship(""), options...)
+	return
+}
+
 // ------------------------------------------------------------------------------------------------
 // Functions specific to Discord Guilds
 // ------------------------------------------------------------------------------------------------
 
 // Guild returns a Guild structure of a specific Guild.
 // guildID   : The ID of a Guild
-func (s *Session) Guild(guildID string) (st *Guild, err error) {
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuild(guildID), nil, http.EndpointGuild(guildID))
+func (s *Session) Guild(guildID string, options ...RequestOption) (st *Guild, err error) {
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuild(guildID), nil, http.EndpointGuild(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -477,8 +859,8 @@ func (s *Session) Guild(guildID string) (st *Guild, err error) {
 
 // GuildPreview returns a GuildPreview structure of a specific public Guild.
 // guildID   : The ID of a Guild
-func (s *Session) GuildPreview(guildID string) (st *GuildPreview, err error) {
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildPreview(guildID), nil, http.EndpointGuildPreview(guildID))
+func (s *Session) GuildPreview(guildID string, options ...RequestOption) (st *GuildPreview, err error) {
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildPreview(guildID), nil, http.EndpointGuildPreview(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -489,13 +871,13 @@ func (s *Session) GuildPreview(guildID string) (st *GuildPreview, err error) {
 
 // GuildCreate creates a new Guild
 // name      : A name for the Guild (2-100 characters)
-func (s *Session) GuildCreate(name string) (st *Guild, err error) {
+func (s *Session) GuildCreate(name string, options ...RequestOption) (st *Guild, err error) {
 
 	data := struct {
 		Name string `json:"name"`
 	}{name}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildCreate, data, http.EndpointGuildCreate)
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildCreate, data, http.EndpointGuildCreate, options...)
 	if err != nil {
 		return
 	}
@@ -507,7 +889,7 @@ func (s *Session) GuildCreate(name string) (st *Guild, err error) {
 // GuildEdit edits a new Guild
 // guildID   : The ID of a Guild
 // g 		 : A GuildParams struct with the values Name, Region and VerificationLevel defined.
-func (s *Session) GuildEdit(guildID string, g GuildParams) (st *Guild, err error) {
+func (s *Session) GuildEdit(guildID string, g GuildParams, options ...RequestOption) (st *Guild, err error) {
 
 	// Bounds checking for VerificationLevel, interval: [0, 4]
 	if g.VerificationLevel != nil {
@@ -521,7 +903,7 @@ func (s *Session) GuildEdit(guildID string, g GuildParams) (st *Guild, err error
 	//Bounds checking for regions
 	if g.Region != "" {
 		isValid := false
-		regions, _ := s.VoiceRegions()
+		regions, _ := s.VoiceRegions(options...)
 		for _, r := range regions {
 			if g.Region == r.ID {
 				isValid = true
@@ -537,7 +919,7 @@ func (s *Session) GuildEdit(guildID string, g GuildParams) (st *Guild, err error
 		}
 	}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuild(guildID), g, http.EndpointGuild(guildID))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuild(guildID), g, http.EndpointGuild(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -548,9 +930,9 @@ func (s *Session) GuildEdit(guildID string, g GuildParams) (st *Guild, err error
 
 // GuildDelete deletes a Guild.
 // guildID   : The ID of a Guild
-func (s *Session) GuildDelete(guildID string) (st *Guild, err error) {
+func (s *Session) GuildDelete(guildID string, options ...RequestOption) (st *Guild, err error) {
 
-	body, err := s.RequestWithBucketID("DELETE", http.EndpointGuild(guildID), nil, http.EndpointGuild(guildID))
+	body, err := s.RequestWithBucketID("DELETE", http.EndpointGuild(guildID), nil, http.EndpointGuild(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -561,18 +943,37 @@ func (s *Session) GuildDelete(guildID string) (st *Guild, err error) {
 
 // GuildLeave leaves a Guild.
 // guildID   : The ID of a Guild
-func (s *Session) GuildLeave(guildID string) (err error) {
+func (s *Session) GuildLeave(guildID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointUserGuild("@me", guildID), nil, http.EndpointUserGuild("", guildID))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointUserGuild("@me", guildID), nil, http.EndpointUserGuild("", guildID), options...)
 	return
 }
 
-// GuildBans returns an array of GuildBan structures for all bans of a
+// GuildBans returns an array of GuildBan structures for the bans of a
 // given guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildBans(guildID string) (st []*GuildBan, err error) {
+// limit     : Max number of bans to return (max 1000)
+// beforeID  : If provided all bans returned will be of users before the given ID.
+// afterID   : If provided all bans returned will be of users after the given ID.
+func (s *Session) GuildBans(guildID string, limit int, beforeID, afterID string, options ...RequestOption) (st []*GuildBan, err error) {
+	uri := http.EndpointGuildBans(guildID)
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildBans(guildID), nil, http.EndpointGuildBans(guildID))
+	v := url.Values{}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if beforeID != "" {
+		v.Set("before", beforeID)
+	}
+	if afterID != "" {
+		v.Set("after", afterID)
+	}
+
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildBans(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -586,14 +987,14 @@ func (s *Session) GuildBans(guildID string) (st []*GuildBan, err error) {
 // guildID   : The ID of a Guild.
 // userID    : The ID of a User
 // days      : The number of days of previous comments to delete.
-func (s *Session) GuildBanCreate(guildID, userID string, days int) (err error) {
-	return s.GuildBanCreateWithReason(guildID, userID, "", days)
+func (s *Session) GuildBanCreate(guildID, userID string, days int, options ...RequestOption) (err error) {
+	return s.GuildBanCreateWithReason(guildID, userID, "", days, options...)
 }
 
 // GuildBan finds ban by given guild and user id and returns GuildBan structure
-func (s *Session) GuildBan(guildID, userID string) (st *GuildBan, err error) {
+func (s *Session) GuildBan(guildID, userID string, options ...RequestOption) (st *GuildBan, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildBan(guildID, userID), nil, http.EndpointGuildBan(guildID, userID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildBan(guildID, userID), nil, http.EndpointGuildBan(guildID, userID), options...)
 	if err != nil {
 		return
 	}
@@ -608,7 +1009,7 @@ func (s *Session) GuildBan(guildID, userID string) (st *GuildBan, err error) {
 // userID    : The ID of a User
 // reason    : The reason for this ban
 // days      : The number of days of previous comments to delete.
-func (s *Session) GuildBanCreateWithReason(guildID, userID, reason string, days int) (err error) {
+func (s *Session) GuildBanCreateWithReason(guildID, userID, reason string, days int, options ...RequestOption) (err error) {
 
 	uri := http.EndpointGuildBan(guildID, userID)
 
@@ -624,16 +1025,28 @@ func (s *Session) GuildBanCreateWithReason(guildID, userID, reason string, days
 		uri += "?" + queryParams.Encode()
 	}
 
-	_, err = s.RequestWithBucketID("PUT", uri, nil, http.EndpointGuildBan(guildID, ""))
+	_, err = s.RequestWithBucketID("PUT", uri, nil, http.EndpointGuildBan(guildID, ""), options...)
 	return
 }
 
 // GuildBanDelete removes the given user from the guild bans
 // guildID   : The ID of a Guild.
 // userID    : The ID of a User
-func (s *Session) GuildBanDelete(guildID, userID string) (err error) {
+func (s *Session) GuildBanDelete(guildID, userID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildBan(guildID, userID), nil, http.EndpointGuildBan(guildID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildBan(guildID, userID), nil, http.EndpointGuildBan(guildID, ""), options...)
+	return
+}
+
+// GuildAck marks every channel of a guild as read. Like ChannelMessageAck,
+// it also updates the read states of the session's State.
+// guildID   : The ID of a Guild.
+func (s *Session) GuildAck(guildID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("POST", http.EndpointGuildAck(guildID), struct{}{}, http.EndpointGuildAck(guildID), options...)
+	if err == nil && s.StateEnabled {
+		s.State.guildAck(guildID)
+	}
 	return
 }
 
@@ -641,7 +1054,7 @@ func (s *Session) GuildBanDelete(guildID, userID string) (err error) {
 //  guildID  : The ID of a Guild.
 //  after    : The id of the member to return members after
 //  limit    : max number of members to return (max 1000)
-func (s *Session) GuildMembers(guildID string, after string, limit int) (st []*Member, err error) {
+func (s *Session) GuildMembers(guildID string, after string, limit int, options ...RequestOption) (st []*Member, err error) {
 
 	uri := http.EndpointGuildMembers(guildID)
 
@@ -659,7 +1072,7 @@ func (s *Session) GuildMembers(guildID string, after string, limit int) (st []*M
 		uri += "?" + v.Encode()
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildMembers(guildID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildMembers(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -671,9 +1084,9 @@ func (s *Session) GuildMembers(guildID string, after string, limit int) (st []*M
 // GuildMember returns a member of a guild.
 //  guildID   : The ID of a Guild.
 //  userID    : The ID of a User
-func (s *Session) GuildMember(guildID, userID string) (st *Member, err error) {
+func (s *Session) GuildMember(guildID, userID string, options ...RequestOption) (st *Member, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildMember(guildID, userID), nil, http.EndpointGuildMember(guildID, ""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildMember(guildID, userID), nil, http.EndpointGuildMember(guildID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -692,7 +1105,7 @@ func (s *Session) GuildMember(guildID, userID string) (st *Member, err error) {
 //  roles         : A list of role ID's to set on the member.
 //  mute          : If the user is muted.
 //  deaf          : If the user is deafened.
-func (s *Session) GuildMemberAdd(accessToken, guildID, userID, nick string, roles []string, mute, deaf bool) (err error) {
+func (s *Session) GuildMemberAdd(accessToken, guildID, userID, nick string, roles []string, mute, deaf bool, options ...RequestOption) (err error) {
 
 	data := struct {
 		AccessToken string   `json:"access_token"`
@@ -702,7 +1115,7 @@ func (s *Session) GuildMemberAdd(accessToken, guildID, userID, nick string, role
 		Deaf        bool     `json:"deaf,omitempty"`
 	}{accessToken, nick, roles, mute, deaf}
 
-	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	if err != nil {
 		return err
 	}
@@ -713,23 +1126,23 @@ func (s *Session) GuildMemberAdd(accessToken, guildID, userID, nick string, role
 // GuildMemberDelete removes the given user from the given guild.
 // guildID   : The ID of a Guild.
 // userID    : The ID of a User
-func (s *Session) GuildMemberDelete(guildID, userID string) (err error) {
+func (s *Session) GuildMemberDelete(guildID, userID string, options ...RequestOption) (err error) {
 
-	return s.GuildMemberDeleteWithReason(guildID, userID, "")
+	return s.GuildMemberDeleteWithReason(guildID, userID, "", options...)
 }
 
 // GuildMemberDeleteWithReason removes the given user from the given guild.
 // guildID   : The ID of a Guild.
 // userID    : The ID of a User
 // reason    : The reason for the kick
-func (s *Session) GuildMemberDeleteWithReason(guildID, userID, reason string) (err error) {
+func (s *Session) GuildMemberDeleteWithReason(guildID, userID, reason string, options ...RequestOption) (err error) {
 
 	uri := http.EndpointGuildMember(guildID, userID)
 	if reason != "" {
 		uri += "?reason=" + url.QueryEscape(reason)
 	}
 
-	_, err = s.RequestWithBucketID("DELETE", uri, nil, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("DELETE", uri, nil, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -737,13 +1150,13 @@ func (s *Session) GuildMemberDeleteWithReason(guildID, userID, reason string) (e
 // guildID  : The ID of a Guild.
 // userID   : The ID of a User.
 // roles    : A list of role ID's to set on the member.
-func (s *Session) GuildMemberEdit(guildID, userID string, roles []string) (err error) {
+func (s *Session) GuildMemberEdit(guildID, userID string, roles []string, options ...RequestOption) (err error) {
 
 	data := struct {
 		Roles []string `json:"roles"`
 	}{roles}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -753,12 +1166,12 @@ func (s *Session) GuildMemberEdit(guildID, userID string, roles []string) (err e
 //  channelID : The ID of a channel to move user to or nil to remove from voice channel
 // NOTE : I am not entirely set on the name of this function and it may change
 // prior to the final 1.0.0 release of Discordgo
-func (s *Session) GuildMemberMove(guildID string, userID string, channelID *string) (err error) {
+func (s *Session) GuildMemberMove(guildID string, userID string, channelID *string, options ...RequestOption) (err error) {
 	data := struct {
 		ChannelID *string `json:"channel_id"`
 	}{channelID}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -767,7 +1180,7 @@ func (s *Session) GuildMemberMove(guildID string, userID string, channelID *stri
 // userID    : The ID of a user
 // userID    : The ID of a user or "@me" which is a shortcut of the current user ID
 // nickname  : The nickname of the member, "" will reset their nickname
-func (s *Session) GuildMemberNickname(guildID, userID, nickname string) (err error) {
+func (s *Session) GuildMemberNickname(guildID, userID, nickname string, options ...RequestOption) (err error) {
 
 	data := struct {
 		Nick string `json:"nick"`
@@ -777,7 +1190,7 @@ func (s *Session) GuildMemberNickname(guildID, userID, nickname string) (err err
 		userID += "/nick"
 	}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -785,12 +1198,12 @@ func (s *Session) GuildMemberNickname(guildID, userID, nickname string) (err err
 //  guildID   : The ID of a Guild.
 //  userID    : The ID of a User.
 //  mute    : boolean value for if the user should be muted
-func (s *Session) GuildMemberMute(guildID string, userID string, mute bool) (err error) {
+func (s *Session) GuildMemberMute(guildID string, userID string, mute bool, options ...RequestOption) (err error) {
 	data := struct {
 		Mute bool `json:"mute"`
 	}{mute}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -799,12 +1212,12 @@ func (s *Session) GuildMemberMute(guildID string, userID string, mute bool) (err
 //  userID    : The ID of a User.
 //  until     : The timestamp for how long a member should be timed out.
 //              Set to nil to remove timeout.
-func (s *Session) GuildMemberTimeout(guildID string, userID string, until *time.Time) (err error) {
+func (s *Session) GuildMemberTimeout(guildID string, userID string, until *time.Time, options ...RequestOption) (err error) {
 	data := struct {
 		CommunicationDisabledUntil *time.Time `json:"communication_disabled_until"`
 	}{until}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -812,12 +1225,12 @@ func (s *Session) GuildMemberTimeout(guildID string, userID string, until *time.
 //  guildID   : The ID of a Guild.
 //  userID    : The ID of a User.
 //  deaf    : boolean value for if the user should be deafened
-func (s *Session) GuildMemberDeafen(guildID string, userID string, deaf bool) (err error) {
+func (s *Session) GuildMemberDeafen(guildID string, userID string, deaf bool, options ...RequestOption) (err error) {
 	data := struct {
 		Deaf bool `json:"deaf"`
 	}{deaf}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildMember(guildID, userID), data, http.EndpointGuildMember(guildID, ""), options...)
 	return
 }
 
@@ -825,9 +1238,9 @@ func (s *Session) GuildMemberDeafen(guildID string, userID string, deaf bool) (e
 //  guildID   : The ID of a Guild.
 //  userID    : The ID of a User.
 //  roleID 	  : The ID of a Role to be assigned to the user.
-func (s *Session) GuildMemberRoleAdd(guildID, userID, roleID string) (err error) {
+func (s *Session) GuildMemberRoleAdd(guildID, userID, roleID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildMemberRole(guildID, userID, roleID), nil, http.EndpointGuildMemberRole(guildID, "", ""))
+	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildMemberRole(guildID, userID, roleID), nil, http.EndpointGuildMemberRole(guildID, "", ""), options...)
 
 	return
 }
@@ -836,9 +1249,9 @@ func (s *Session) GuildMemberRoleAdd(guildID, userID, roleID string) (err error)
 //  guildID   : The ID of a Guild.
 //  userID    : The ID of a User.
 //  roleID 	  : The ID of a Role to be removed from the user.
-func (s *Session) GuildMemberRoleRemove(guildID, userID, roleID string) (err error) {
+func (s *Session) GuildMemberRoleRemove(guildID, userID, roleID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildMemberRole(guildID, userID, roleID), nil, http.EndpointGuildMemberRole(guildID, "", ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildMemberRole(guildID, userID, roleID), nil, http.EndpointGuildMemberRole(guildID, "", ""), options...)
 
 	return
 }
@@ -846,9 +1259,9 @@ func (s *Session) GuildMemberRoleRemove(guildID, userID, roleID string) (err err
 // GuildChannels returns an array of Channel structures for all channels of a
 // given guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildChannels(guildID string) (st []*Channel, err error) {
+func (s *Session) GuildChannels(guildID string, options ...RequestOption) (st []*Channel, err error) {
 
-	body, err := s.request("GET", http.EndpointGuildChannels(guildID), "", nil, http.EndpointGuildChannels(guildID), 0)
+	body, err := s.request("GET", http.EndpointGuildChannels(guildID), "", nil, http.EndpointGuildChannels(guildID), 0, options...)
 	if err != nil {
 		return
 	}
@@ -870,13 +1283,21 @@ type GuildChannelCreateData struct {
 	PermissionOverwrites []*PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID             string                 `json:"parent_id,omitempty"`
 	NSFW                 bool                   `json:"nsfw,omitempty"`
+
+	// NOTE: forum channels only
+
+	AvailableTags                 []ForumTag            `json:"available_tags,omitempty"`
+	DefaultReactionEmoji          *ForumDefaultReaction `json:"default_reaction_emoji,omitempty"`
+	DefaultThreadRateLimitPerUser int                   `json:"default_thread_rate_limit_per_user,omitempty"`
+	DefaultSortOrder              *ForumSortOrderType   `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            ForumLayout           `json:"default_forum_layout,omitempty"`
 }
 
 // GuildChannelCreateComplex creates a new channel in the given guild
 // guildID      : The ID of a Guild
 // data         : A data struct describing the new Channel, Name and Type are mandatory, other fields depending on the type
-func (s *Session) GuildChannelCreateComplex(guildID string, data GuildChannelCreateData) (st *Channel, err error) {
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildChannels(guildID), data, http.EndpointGuildChannels(guildID))
+func (s *Session) GuildChannelCreateComplex(guildID string, data GuildChannelCreateData, options ...RequestOption) (st *Channel, err error) {
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildChannels(guildID), data, http.EndpointGuildChannels(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -889,17 +1310,17 @@ func (s *Session) GuildChannelCreateComplex(guildID string, data GuildChannelCre
 // guildID   : The ID of a Guild.
 // name      : Name of the channel (2-100 chars length)
 // ctype     : Type of the channel
-func (s *Session) GuildChannelCreate(guildID, name string, ctype ChannelType) (st *Channel, err error) {
+func (s *Session) GuildChannelCreate(guildID, name string, ctype ChannelType, options ...RequestOption) (st *Channel, err error) {
 	return s.GuildChannelCreateComplex(guildID, GuildChannelCreateData{
 		Name: name,
 		Type: ctype,
-	})
+	}, options...)
 }
 
 // GuildChannelsReorder updates the order of channels in a guild
 // guildID   : The ID of a Guild.
 // channels  : Updated channels.
-func (s *Session) GuildChannelsReorder(guildID string, channels []*Channel) (err error) {
+func (s *Session) GuildChannelsReorder(guildID string, channels []*Channel, options ...RequestOption) (err error) {
 
 	data := make([]struct {
 		ID       string `json:"id"`
@@ -911,14 +1332,14 @@ func (s *Session) GuildChannelsReorder(guildID string, channels []*Channel) (err
 		data[i].Position = c.Position
 	}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildChannels(guildID), data, http.EndpointGuildChannels(guildID))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildChannels(guildID), data, http.EndpointGuildChannels(guildID), options...)
 	return
 }
 
 // GuildInvites returns an array of Invite structures for the given guild
 // guildID   : The ID of a Guild.
-func (s *Session) GuildInvites(guildID string) (st []*Invite, err error) {
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildInvites(guildID), nil, http.EndpointGuildInvites(guildID))
+func (s *Session) GuildInvites(guildID string, options ...RequestOption) (st []*Invite, err error) {
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildInvites(guildID), nil, http.EndpointGuildInvites(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -929,9 +1350,9 @@ func (s *Session) GuildInvites(guildID string) (st []*Invite, err error) {
 
 // GuildRoles returns all roles for a given guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildRoles(guildID string) (st []*Role, err error) {
+func (s *Session) GuildRoles(guildID string, options ...RequestOption) (st []*Role, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildRoles(guildID), nil, http.EndpointGuildRoles(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildRoles(guildID), nil, http.EndpointGuildRoles(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -943,9 +1364,9 @@ func (s *Session) GuildRoles(guildID string) (st []*Role, err error) {
 
 // GuildRoleCreate returns a new Guild Role.
 // guildID: The ID of a Guild.
-func (s *Session) GuildRoleCreate(guildID string) (st *Role, err error) {
+func (s *Session) GuildRoleCreate(guildID string, options ...RequestOption) (st *Role, err error) {
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildRoles(guildID), nil, http.EndpointGuildRoles(guildID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildRoles(guildID), nil, http.EndpointGuildRoles(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -963,7 +1384,7 @@ func (s *Session) GuildRoleCreate(guildID string) (st *Role, err error) {
 // hoist     : Whether to display the role's users separately.
 // perm      : The permissions for the role.
 // mention   : Whether this role is mentionable
-func (s *Session) GuildRoleEdit(guildID, roleID, name string, color int, hoist bool, perm int64, mention bool) (st *Role, err error) {
+func (s *Session) GuildRoleEdit(guildID, roleID, name string, color int, hoist bool, perm int64, mention bool, options ...RequestOption) (st *Role, err error) {
 
 	// Prevent sending a color int that is too big.
 	if color > 0xFFFFFF {
@@ -979,7 +1400,7 @@ func (s *Session) GuildRoleEdit(guildID, roleID, name string, color int, hoist b
 		Mentionable bool   `json:"mentionable"`        // Whether this role is mentionable
 	}{name, color, hoist, perm, mention}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildRole(guildID, roleID), data, http.EndpointGuildRole(guildID, ""))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildRole(guildID, roleID), data, http.EndpointGuildRole(guildID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -992,9 +1413,9 @@ func (s *Session) GuildRoleEdit(guildID, roleID, name string, color int, hoist b
 // GuildRoleReorder reoders guild roles
 // guildID   : The ID of a Guild.
 // roles     : A list of ordered roles.
-func (s *Session) GuildRoleReorder(guildID string, roles []*Role) (st []*Role, err error) {
+func (s *Session) GuildRoleReorder(guildID string, roles []*Role, options ...RequestOption) (st []*Role, err error) {
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildRoles(guildID), roles, http.EndpointGuildRoles(guildID))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildRoles(guildID), roles, http.EndpointGuildRoles(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1007,9 +1428,9 @@ func (s *Session) GuildRoleReorder(guildID string, roles []*Role) (st []*Role, e
 // GuildRoleDelete deletes an existing role.
 // guildID   : The ID of a Guild.
 // roleID    : The ID of a Role.
-func (s *Session) GuildRoleDelete(guildID, roleID string) (err error) {
+func (s *Session) GuildRoleDelete(guildID, roleID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildRole(guildID, roleID), nil, http.EndpointGuildRole(guildID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildRole(guildID, roleID), nil, http.EndpointGuildRole(guildID, ""), options...)
 
 	return
 }
@@ -1018,7 +1439,7 @@ func (s *Session) GuildRoleDelete(guildID, roleID string) (err error) {
 // Requires 'KICK_MEMBER' permission.
 // guildID	: The ID of a Guild.
 // days		: The number of days to count prune for (1 or more).
-func (s *Session) GuildPruneCount(guildID string, days uint32) (count uint32, err error) {
+func (s *Session) GuildPruneCount(guildID string, days uint32, options ...RequestOption) (count uint32, err error) {
 	count = 0
 
 	if days <= 0 {
@@ -1031,7 +1452,7 @@ func (s *Session) GuildPruneCount(guildID string, days uint32) (count uint32, er
 	}{}
 
 	uri := http.EndpointGuildPrune(guildID) + "?days=" + strconv.FormatUint(uint64(days), 10)
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildPrune(guildID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildPrune(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1050,7 +1471,7 @@ func (s *Session) GuildPruneCount(guildID string, days uint32) (count uint32, er
 // Returns an object with one 'pruned' key indicating the number of members that were removed in the prune operation.
 // guildID	: The ID of a Guild.
 // days		: The number of days to count prune for (1 or more).
-func (s *Session) GuildPrune(guildID string, days uint32) (count uint32, err error) {
+func (s *Session) GuildPrune(guildID string, days uint32, options ...RequestOption) (count uint32, err error) {
 
 	count = 0
 
@@ -1067,7 +1488,7 @@ func (s *Session) GuildPrune(guildID string, days uint32) (count uint32, err err
 		Pruned uint32 `json:"pruned"`
 	}{}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildPrune(guildID), data, http.EndpointGuildPrune(guildID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildPrune(guildID), data, http.EndpointGuildPrune(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1084,9 +1505,9 @@ func (s *Session) GuildPrune(guildID string, days uint32) (count uint32, err err
 
 // GuildIntegrations returns an array of Integrations for a guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildIntegrations(guildID string) (st []*Integration, err error) {
+func (s *Session) GuildIntegrations(guildID string, options ...RequestOption) (st []*Integration, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildIntegrations(guildID), nil, http.EndpointGuildIntegrations(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildIntegrations(guildID), nil, http.EndpointGuildIntegrations(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1100,14 +1521,14 @@ func (s *Session) GuildIntegrations(guildID string) (st []*Integration, err erro
 // guildID          : The ID of a Guild.
 // integrationType  : The Integration type.
 // integrationID    : The ID of an integration.
-func (s *Session) GuildIntegrationCreate(guildID, integrationType, integrationID string) (err error) {
+func (s *Session) GuildIntegrationCreate(guildID, integrationType, integrationID string, options ...RequestOption) (err error) {
 
 	data := struct {
 		Type string `json:"type"`
 		ID   string `json:"id"`
 	}{integrationType, integrationID}
 
-	_, err = s.RequestWithBucketID("POST", http.EndpointGuildIntegrations(guildID), data, http.EndpointGuildIntegrations(guildID))
+	_, err = s.RequestWithBucketID("POST", http.EndpointGuildIntegrations(guildID), data, http.EndpointGuildIntegrations(guildID), options...)
 	return
 }
 
@@ -1118,7 +1539,7 @@ func (s *Session) GuildIntegrationCreate(guildID, integrationType, integrationID
 // expireBehavior	      : The behavior when an integration subscription lapses (see the integration object documentation).
 // expireGracePeriod    : Period (in seconds) where the integration will ignore lapsed subscriptions.
 // enableEmoticons	    : Whether emoticons should be synced for this integration (twitch only currently).
-func (s *Session) GuildIntegrationEdit(guildID, integrationID string, expireBehavior, expireGracePeriod int, enableEmoticons bool) (err error) {
+func (s *Session) GuildIntegrationEdit(guildID, integrationID string, expireBehavior, expireGracePeriod int, enableEmoticons bool, options ...RequestOption) (err error) {
 
 	data := struct {
 		ExpireBehavior    int  `json:"expire_behavior"`
@@ -1126,23 +1547,23 @@ func (s *Session) GuildIntegrationEdit(guildID, integrationID string, expireBeha
 		EnableEmoticons   bool `json:"enable_emoticons"`
 	}{expireBehavior, expireGracePeriod, enableEmoticons}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildIntegration(guildID, integrationID), data, http.EndpointGuildIntegration(guildID, ""))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildIntegration(guildID, integrationID), data, http.EndpointGuildIntegration(guildID, ""), options...)
 	return
 }
 
 // GuildIntegrationDelete removes the given integration from the Guild.
 // guildID          : The ID of a Guild.
 // integrationID    : The ID of an integration.
-func (s *Session) GuildIntegrationDelete(guildID, integrationID string) (err error) {
+func (s *Session) GuildIntegrationDelete(guildID, integrationID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildIntegration(guildID, integrationID), nil, http.EndpointGuildIntegration(guildID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildIntegration(guildID, integrationID), nil, http.EndpointGuildIntegration(guildID, ""), options...)
 	return
 }
 
 // GuildIcon returns an image.Image of a guild icon.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildIcon(guildID string) (img image.Image, err error) {
-	g, err := s.Guild(guildID)
+func (s *Session) GuildIcon(guildID string, options ...RequestOption) (img image.Image, err error) {
+	g, err := s.Guild(guildID, options...)
 	if err != nil {
 		return
 	}
@@ -1152,7 +1573,7 @@ func (s *Session) GuildIcon(guildID string) (img image.Image, err error) {
 		return
 	}
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildIcon(guildID, g.Icon), nil, http.EndpointGuildIcon(guildID, ""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildIcon(guildID, g.Icon), nil, http.EndpointGuildIcon(guildID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -1163,8 +1584,8 @@ func (s *Session) GuildIcon(guildID string) (img image.Image, err error) {
 
 // GuildSplash returns an image.Image of a guild splash image.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildSplash(guildID string) (img image.Image, err error) {
-	g, err := s.Guild(guildID)
+func (s *Session) GuildSplash(guildID string, options ...RequestOption) (img image.Image, err error) {
+	g, err := s.Guild(guildID, options...)
 	if err != nil {
 		return
 	}
@@ -1174,7 +1595,7 @@ func (s *Session) GuildSplash(guildID string) (img image.Image, err error) {
 		return
 	}
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildSplash(guildID, g.Splash), nil, http.EndpointGuildSplash(guildID, ""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildSplash(guildID, g.Splash), nil, http.EndpointGuildSplash(guildID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -1185,9 +1606,9 @@ func (s *Session) GuildSplash(guildID string) (img image.Image, err error) {
 
 // GuildEmbed returns the embed for a Guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildEmbed(guildID string) (st *GuildEmbed, err error) {
+func (s *Session) GuildEmbed(guildID string, options ...RequestOption) (st *GuildEmbed, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildEmbed(guildID), nil, http.EndpointGuildEmbed(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildEmbed(guildID), nil, http.EndpointGuildEmbed(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1198,11 +1619,11 @@ func (s *Session) GuildEmbed(guildID string) (st *GuildEmbed, err error) {
 
 // GuildEmbedEdit returns the embed for a Guild.
 // guildID   : The ID of a Guild.
-func (s *Session) GuildEmbedEdit(guildID string, enabled bool, channelID string) (err error) {
+func (s *Session) GuildEmbedEdit(guildID string, enabled bool, channelID string, options ...RequestOption) (err error) {
 
 	data := GuildEmbed{enabled, channelID}
 
-	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildEmbed(guildID), data, http.EndpointGuildEmbed(guildID))
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildEmbed(guildID), data, http.EndpointGuildEmbed(guildID), options...)
 	return
 }
 
@@ -1212,7 +1633,7 @@ func (s *Session) GuildEmbedEdit(guildID string, enabled bool, channelID string)
 // beforeID    : If provided all log entries returned will be before the given ID.
 // actionType  : If provided the log will be filtered for the given Action Type.
 // limit       : The number messages that can be returned. (default 50, min 1, max 100)
-func (s *Session) GuildAuditLog(guildID, userID, beforeID string, actionType, limit int) (st *GuildAuditLog, err error) {
+func (s *Session) GuildAuditLog(guildID, userID, beforeID string, actionType, limit int, options ...RequestOption) (st *GuildAuditLog, err error) {
 
 	uri := http.EndpointGuildAuditLogs(guildID)
 
@@ -1233,7 +1654,7 @@ func (s *Session) GuildAuditLog(guildID, userID, beforeID string, actionType, li
 		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildAuditLogs(guildID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildAuditLogs(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1244,9 +1665,9 @@ func (s *Session) GuildAuditLog(guildID, userID, beforeID string, actionType, li
 
 // GuildEmojis returns all emoji
 // guildID : The ID of a Guild.
-func (s *Session) GuildEmojis(guildID string) (emoji []*Emoji, err error) {
+func (s *Session) GuildEmojis(guildID string, options ...RequestOption) (emoji []*Emoji, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildEmojis(guildID), nil, http.EndpointGuildEmojis(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildEmojis(guildID), nil, http.EndpointGuildEmojis(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1260,7 +1681,7 @@ func (s *Session) GuildEmojis(guildID string) (emoji []*Emoji, err error) {
 // name    : The Name of the Emoji.
 // image   : The base64 encoded emoji image, has to be smaller than 256KB.
 // roles   : The roles for which this emoji will be whitelisted, can be nil.
-func (s *Session) GuildEmojiCreate(guildID, name, image string, roles []string) (emoji *Emoji, err error) {
+func (s *Session) GuildEmojiCreate(guildID, name, image string, roles []string, options ...RequestOption) (emoji *Emoji, err error) {
 
 	data := struct {
 		Name  string   `json:"name"`
@@ -1268,7 +1689,7 @@ func (s *Session) GuildEmojiCreate(guildID, name, image string, roles []string)
 		Roles []string `json:"roles,omitempty"`
 	}{name, image, roles}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildEmojis(guildID), data, http.EndpointGuildEmojis(guildID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildEmojis(guildID), data, http.EndpointGuildEmojis(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1282,14 +1703,14 @@ func (s *Session) GuildEmojiCreate(guildID, name, image string, roles []string)
 // emojiID : The ID of an Emoji.
 // name    : The Name of the Emoji.
 // roles   : The roles for which this emoji will be whitelisted, can be nil.
-func (s *Session) GuildEmojiEdit(guildID, emojiID, name string, roles []string) (emoji *Emoji, err error) {
+func (s *Session) GuildEmojiEdit(guildID, emojiID, name string, roles []string, options ...RequestOption) (emoji *Emoji, err error) {
 
 	data := struct {
 		Name  string   `json:"name"`
 		Roles []string `json:"roles,omitempty"`
 	}{name, roles}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildEmoji(guildID, emojiID), data, http.EndpointGuildEmojis(guildID))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildEmoji(guildID, emojiID), data, http.EndpointGuildEmojis(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1301,17 +1722,17 @@ func (s *Session) GuildEmojiEdit(guildID, emojiID, name string, roles []string)
 // GuildEmojiDelete deletes an Emoji.
 // guildID : The ID of a Guild.
 // emojiID : The ID of an Emoji.
-func (s *Session) GuildEmojiDelete(guildID, emojiID string) (err error) {
+func (s *Session) GuildEmojiDelete(guildID, emojiID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildEmoji(guildID, emojiID), nil, http.EndpointGuildEmojis(guildID))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildEmoji(guildID, emojiID), nil, http.EndpointGuildEmojis(guildID), options...)
 	return
 }
 
 // GuildTemplate returns a GuildTemplate for the given code
 // templateCode: The Code of a GuildTemplate
-func (s *Session) GuildTemplate(templateCode string) (st *GuildTemplate, err error) {
+func (s *Session) GuildTemplate(templateCode string, options ...RequestOption) (st *GuildTemplate, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildTemplate(templateCode), nil, http.EndpointGuildTemplate(templateCode))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildTemplate(templateCode), nil, http.EndpointGuildTemplate(templateCode), options...)
 	if err != nil {
 		return
 	}
@@ -1324,14 +1745,14 @@ func (s *Session) GuildTemplate(templateCode string) (st *GuildTemplate, err err
 // templateCode: The Code of a GuildTemplate
 // name: The name of the guild (2-100) characters
 // icon: base64 encoded 128x128 image for the guild icon
-func (s *Session) GuildCreateWithTemplate(templateCode, name, icon string) (st *Guild, err error) {
+func (s *Session) GuildCreateWithTemplate(templateCode, name, icon string, options ...RequestOption) (st *Guild, err error) {
 
 	data := struct {
 		Name string `json:"name"`
 		Icon string `json:"icon"`
 	}{name, icon}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildTemplate(templateCode), data, http.EndpointGuildTemplate(templateCode))
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildTemplate(templateCode), data, http.EndpointGuildTemplate(templateCode), options...)
 	if err != nil {
 		return
 	}
@@ -1342,9 +1763,9 @@ func (s *Session) GuildCreateWithTemplate(templateCode, name, icon string) (st *
 
 // GuildTemplates returns all of GuildTemplates
 // guildID: The ID of the guild
-func (s *Session) GuildTemplates(guildID string) (st []*GuildTemplate, err error) {
+func (s *Session) GuildTemplates(guildID string, options ...RequestOption) (st []*GuildTemplate, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildTemplates(guildID), nil, http.EndpointGuildTemplates(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildTemplates(guildID), nil, http.EndpointGuildTemplates(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1357,14 +1778,14 @@ func (s *Session) GuildTemplates(guildID string) (st []*GuildTemplate, err error
 // guildID: The ID of the guild
 // name: The name of the template (1-100 characters)
 // description: The description for the template (0-120 characters)
-func (s *Session) GuildTemplateCreate(guildID, name, description string) (st *GuildTemplate) {
+func (s *Session) GuildTemplateCreate(guildID, name, description string, options ...RequestOption) (st *GuildTemplate) {
 
 	data := struct {
 		Name        string `json:"name"`
 		Description string `json:"description"`
 	}{name, description}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildTemplates(guildID), data, http.EndpointGuildTemplates(guildID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildTemplates(guildID), data, http.EndpointGuildTemplates(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -1376,9 +1797,9 @@ func (s *Session) GuildTemplateCreate(guildID, name, description string) (st *Gu
 // GuildTemplateSync syncs the template to the guild's current state
 // guildID: The ID of the guild
 // templateCode: The code of the template
-func (s *Session) GuildTemplateSync(guildID, templateCode string) (err error) {
+func (s *Session) GuildTemplateSync(guildID, templateCode string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildTemplateSync(guildID, templateCode), nil, http.EndpointGuildTemplateSync(guildID, ""))
+	_, err = s.RequestWithBucketID("PUT", http.EndpointGuildTemplateSync(guildID, templateCode), nil, http.EndpointGuildTemplateSync(guildID, ""), options...)
 	return
 }
 
@@ -1387,14 +1808,14 @@ func (s *Session) GuildTemplateSync(guildID, templateCode string) (err error) {
 // templateCode: The code of the template
 // name: The name of the template (1-100 characters)
 // description: The description for the template (0-120 characters)
-func (s *Session) GuildTemplateEdit(guildID, templateCode, name, description string) (st *GuildTemplate, err error) {
+func (s *Session) GuildTemplateEdit(guildID, templateCode, name, description string, options ...RequestOption) (st *GuildTemplate, err error) {
 
 	data := struct {
 		Name        string `json:"name,omitempty"`
 		Description string `json:"description,omitempty"`
 	}{name, description}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildTemplateSync(guildID, templateCode), data, http.EndpointGuildTemplateSync(guildID, ""))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildTemplateSync(guildID, templateCode), data, http.EndpointGuildTemplateSync(guildID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -1406,9 +1827,9 @@ func (s *Session) GuildTemplateEdit(guildID, templateCode, name, description str
 // GuildTemplateDelete deletes the template
 // guildID: The ID of the guild
 // templateCode: The code of the template
-func (s *Session) GuildTemplateDelete(guildID, templateCode string) (err error) {
+func (s *Session) GuildTemplateDelete(guildID, templateCode string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildTemplateSync(guildID, templateCode), nil, http.EndpointGuildTemplateSync(guildID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildTemplateSync(guildID, templateCode), nil, http.EndpointGuildTemplateSync(guildID, ""), options...)
 	return
 }
 
@@ -1418,8 +1839,8 @@ func (s *Session) GuildTemplateDelete(guildID, templateCode string) (err error)
 
 // Channel returns a Channel structure of a specific Channel.
 // channelID  : The ID of the Channel you want returned.
-func (s *Session) Channel(channelID string) (st *Channel, err error) {
-	body, err := s.RequestWithBucketID("GET", http.EndpointChannel(channelID), nil, http.EndpointChannel(channelID))
+func (s *Session) Channel(channelID string, options ...RequestOption) (st *Channel, err error) {
+	body, err := s.RequestWithBucketID("GET", http.EndpointChannel(channelID), nil, http.EndpointChannel(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1431,17 +1852,17 @@ func (s *Session) Channel(channelID string) (st *Channel, err error) {
 // ChannelEdit edits the given channel
 // channelID  : The ID of a Channel
 // name       : The new name to assign the channel.
-func (s *Session) ChannelEdit(channelID, name string) (*Channel, error) {
+func (s *Session) ChannelEdit(channelID, name string, options ...RequestOption) (*Channel, error) {
 	return s.ChannelEditComplex(channelID, &ChannelEdit{
 		Name: name,
-	})
+	}, options...)
 }
 
 // ChannelEditComplex edits an existing channel, replacing the parameters entirely with ChannelEdit struct
 // channelID  : The ID of a Channel
 // data          : The channel struct to send
-func (s *Session) ChannelEditComplex(channelID string, data *ChannelEdit) (st *Channel, err error) {
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointChannel(channelID), data, http.EndpointChannel(channelID))
+func (s *Session) ChannelEditComplex(channelID string, data *ChannelEdit, options ...RequestOption) (st *Channel, err error) {
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointChannel(channelID), data, http.EndpointChannel(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1452,9 +1873,9 @@ func (s *Session) ChannelEditComplex(channelID string, data *ChannelEdit) (st *C
 
 // ChannelDelete deletes the given channel
 // channelID  : The ID of a Channel
-func (s *Session) ChannelDelete(channelID string) (st *Channel, err error) {
+func (s *Session) ChannelDelete(channelID string, options ...RequestOption) (st *Channel, err error) {
 
-	body, err := s.RequestWithBucketID("DELETE", http.EndpointChannel(channelID), nil, http.EndpointChannel(channelID))
+	body, err := s.RequestWithBucketID("DELETE", http.EndpointChannel(channelID), nil, http.EndpointChannel(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1466,9 +1887,9 @@ func (s *Session) ChannelDelete(channelID string) (st *Channel, err error) {
 // ChannelTyping broadcasts to all members that authenticated user is typing in
 // the given channel.
 // channelID  : The ID of a Channel
-func (s *Session) ChannelTyping(channelID string) (err error) {
+func (s *Session) ChannelTyping(channelID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("POST", http.EndpointChannelTyping(channelID), nil, http.EndpointChannelTyping(channelID))
+	_, err = s.RequestWithBucketID("POST", http.EndpointChannelTyping(channelID), nil, http.EndpointChannelTyping(channelID), options...)
 	return
 }
 
@@ -1479,7 +1900,7 @@ func (s *Session) ChannelTyping(channelID string) (err error) {
 // beforeID  : If provided all messages returned will be before given ID.
 // afterID   : If provided all messages returned will be after given ID.
 // aroundID  : If provided all messages returned will be around given ID.
-func (s *Session) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) (st []*Message, err error) {
+func (s *Session) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string, options ...RequestOption) (st []*Message, err error) {
 
 	uri := http.EndpointChannelMessages(channelID)
 
@@ -1500,7 +1921,7 @@ func (s *Session) ChannelMessages(channelID string, limit int, beforeID, afterID
 		uri += "?" + v.Encode()
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointChannelMessages(channelID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointChannelMessages(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1512,9 +1933,9 @@ func (s *Session) ChannelMessages(channelID string, limit int, beforeID, afterID
 // ChannelMessage gets a single message by ID from a given channel.
 // channeld  : The ID of a Channel
 // messageID : the ID of a Message
-func (s *Session) ChannelMessage(channelID, messageID string) (st *Message, err error) {
+func (s *Session) ChannelMessage(channelID, messageID string, options ...RequestOption) (st *Message, err error) {
 
-	response, err := s.RequestWithBucketID("GET", http.EndpointChannelMessage(channelID, messageID), nil, http.EndpointChannelMessage(channelID, ""))
+	response, err := s.RequestWithBucketID("GET", http.EndpointChannelMessage(channelID, messageID), nil, http.EndpointChannelMessage(channelID, ""), options...)
 	if err != nil {
 		return
 	}
@@ -1526,10 +1947,10 @@ func (s *Session) ChannelMessage(channelID, messageID string) (st *Message, err
 // ChannelMessageSend sends a message to the given channel.
 // channelID : The ID of a Channel.
 // content   : The message to send.
-func (s *Session) ChannelMessageSend(channelID string, content string) (*Message, error) {
+func (s *Session) ChannelMessageSend(channelID string, content string, options ...RequestOption) (*Message, error) {
 	return s.ChannelMessageSendComplex(channelID, &MessageSend{
 		Content: content,
-	})
+	}, options...)
 }
 
 var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
@@ -1537,7 +1958,7 @@ var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 // ChannelMessageSendComplex sends a message to the given channel.
 // channelID : The ID of a Channel.
 // data      : The message struct to send.
-func (s *Session) ChannelMessageSendComplex(channelID string, data *MessageSend) (st *Message, err error) {
+func (s *Session) ChannelMessageSendComplex(channelID string, data *MessageSend, options ...RequestOption) (st *Message, err error) {
 	// TODO: Remove this when compatibility is not required.
 	if data.Embed != nil {
 		if data.Embeds == nil {
@@ -1573,9 +1994,9 @@ func (s *Session) ChannelMessageSendComplex(channelID string, data *MessageSend)
 			return st, encodeErr
 		}
 
-		response, err = s.request("POST", endpoint, contentType, body, endpoint, 0)
+		response, err = s.request("POST", endpoint, contentType, body, endpoint, 0, options...)
 	} else {
-		response, err = s.RequestWithBucketID("POST", endpoint, data, endpoint)
+		response, err = s.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
 	}
 	if err != nil {
 		return
@@ -1588,41 +2009,41 @@ func (s *Session) ChannelMessageSendComplex(channelID string, data *MessageSend)
 // ChannelMessageSendTTS sends a message to the given channel with Text to Speech.
 // channelID : The ID of a Channel.
 // content   : The message to send.
-func (s *Session) ChannelMessageSendTTS(channelID string, content string) (*Message, error) {
+func (s *Session) ChannelMessageSendTTS(channelID string, content string, options ...RequestOption) (*Message, error) {
 	return s.ChannelMessageSendComplex(channelID, &MessageSend{
 		Content: content,
 		TTS:     true,
-	})
+	}, options...)
 }
 
 // ChannelMessageSendEmbed sends a message to the given channel with embedded data.
 // channelID : The ID of a Channel.
 // embed     : The embed data to send.
-func (s *Session) ChannelMessageSendEmbed(channelID string, embed *MessageEmbed) (*Message, error) {
-	return s.ChannelMessageSendEmbeds(channelID, []*MessageEmbed{embed})
+func (s *Session) ChannelMessageSendEmbed(channelID string, embed *MessageEmbed, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageSendEmbeds(channelID, []*MessageEmbed{embed}, options...)
 }
 
 // ChannelMessageSendEmbeds sends a message to the given channel with multiple embedded data.
 // channelID : The ID of a Channel.
 // embeds    : The embeds data to send.
-func (s *Session) ChannelMessageSendEmbeds(channelID string, embeds []*MessageEmbed) (*Message, error) {
+func (s *Session) ChannelMessageSendEmbeds(channelID string, embeds []*MessageEmbed, options ...RequestOption) (*Message, error) {
 	return s.ChannelMessageSendComplex(channelID, &MessageSend{
 		Embeds: embeds,
-	})
+	}, options...)
 }
 
 // ChannelMessageSendReply sends a message to the given channel with reference data.
 // channelID : The ID of a Channel.
 // content   : The message to send.
 // reference : The message reference to send.
-func (s *Session) ChannelMessageSendReply(channelID string, content string, reference *MessageReference) (*Message, error) {
+func (s *Session) ChannelMessageSendReply(channelID string, content string, reference *MessageReference, options ...RequestOption) (*Message, error) {
 	if reference == nil {
 		return nil, fmt.Errorf("reply attempted with nil message reference")
 	}
 	return s.ChannelMessageSendComplex(channelID, &MessageSend{
 		Content:   content,
 		Reference: reference,
-	})
+	}, options...)
 }
 
 // ChannelMessageEdit edits an existing message, replacing it entirely with
@@ -1630,13 +2051,13 @@ func (s *Session) ChannelMessageSendReply(channelID string, content string, refe
 // channelID  : The ID of a Channel
 // messageID  : The ID of a Message
 // content    : The contents of the message
-func (s *Session) ChannelMessageEdit(channelID, messageID, content string) (*Message, error) {
-	return s.ChannelMessageEditComplex(NewMessageEdit(channelID, messageID).SetContent(content))
+func (s *Session) ChannelMessageEdit(channelID, messageID, content string, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageEditComplex(NewMessageEdit(channelID, messageID).SetContent(content), options...)
 }
 
 // ChannelMessageEditComplex edits an existing message, replacing it entirely with
 // the given MessageEdit struct
-func (s *Session) ChannelMessageEditComplex(m *MessageEdit) (st *Message, err error) {
+func (s *Session) ChannelMessageEditComplex(m *MessageEdit, options ...RequestOption) (st *Message, err error) {
 	// TODO: Remove this when compatibility is not required.
 	if m.Embed != nil {
 		if m.Embeds == nil {
@@ -1652,7 +2073,7 @@ func (s *Session) ChannelMessageEditComplex(m *MessageEdit) (st *Message, err er
 			embed.Type = "rich"
 		}
 	}
-	response, err := s.RequestWithBucketID("PATCH", http.EndpointChannelMessage(m.Channel, m.ID), m, http.EndpointChannelMessage(m.Channel, ""))
+	response, err := s.RequestWithBucketID("PATCH", http.EndpointChannelMessage(m.Channel, m.ID), m, http.EndpointChannelMessage(m.Channel, ""), options...)
 	if err != nil {
 		return
 	}
@@ -1665,22 +2086,22 @@ func (s *Session) ChannelMessageEditComplex(m *MessageEdit) (st *Message, err er
 // channelID : The ID of a Channel
 // messageID : The ID of a Message
 // embed     : The embed data to send
-func (s *Session) ChannelMessageEditEmbed(channelID, messageID string, embed *MessageEmbed) (*Message, error) {
-	return s.ChannelMessageEditEmbeds(channelID, messageID, []*MessageEmbed{embed})
+func (s *Session) ChannelMessageEditEmbed(channelID, messageID string, embed *MessageEmbed, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageEditEmbeds(channelID, messageID, []*MessageEmbed{embed}, options...)
 }
 
 // ChannelMessageEditEmbeds edits an existing message with multiple embedded data.
 // channelID : The ID of a Channel
 // messageID : The ID of a Message
 // embeds    : The embeds data to send
-func (s *Session) ChannelMessageEditEmbeds(channelID, messageID string, embeds []*MessageEmbed) (*Message, error) {
-	return s.ChannelMessageEditComplex(NewMessageEdit(channelID, messageID).SetEmbeds(embeds))
+func (s *Session) ChannelMessageEditEmbeds(channelID, messageID string, embeds []*MessageEmbed, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageEditComplex(NewMessageEdit(channelID, messageID).SetEmbeds(embeds), options...)
 }
 
 // ChannelMessageDelete deletes a message from the Channel.
-func (s *Session) ChannelMessageDelete(channelID, messageID string) (err error) {
+func (s *Session) ChannelMessageDelete(channelID, messageID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelMessage(channelID, messageID), nil, http.EndpointChannelMessage(channelID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelMessage(channelID, messageID), nil, http.EndpointChannelMessage(channelID, ""), options...)
 	return
 }
 
@@ -1689,14 +2110,14 @@ func (s *Session) ChannelMessageDelete(channelID, messageID string) (err error)
 // If the slice is empty do nothing.
 // channelID : The ID of the channel for the messages to delete.
 // messages  : The IDs of the messages to be deleted. A slice of string IDs. A maximum of 100 messages.
-func (s *Session) ChannelMessagesBulkDelete(channelID string, messages []string) (err error) {
+func (s *Session) ChannelMessagesBulkDelete(channelID string, messages []string, options ...RequestOption) (err error) {
 
 	if len(messages) == 0 {
 		return
 	}
 
 	if len(messages) == 1 {
-		err = s.ChannelMessageDelete(channelID, messages[0])
+		err = s.ChannelMessageDelete(channelID, messages[0], options...)
 		return
 	}
 
@@ -1708,34 +2129,53 @@ func (s *Session) ChannelMessagesBulkDelete(channelID string, messages []string)
 		Messages []string `json:"messages"`
 	}{messages}
 
-	_, err = s.RequestWithBucketID("POST", http.EndpointChannelMessagesBulkDelete(channelID), data, http.EndpointChannelMessagesBulkDelete(channelID))
+	_, err = s.RequestWithBucketID("POST", http.EndpointChannelMessagesBulkDelete(channelID), data, http.EndpointChannelMessagesBulkDelete(channelID), options...)
 	return
 }
 
 // ChannelMessagePin pins a message within a given channel.
 // channelID: The ID of a channel.
 // messageID: The ID of a message.
-func (s *Session) ChannelMessagePin(channelID, messageID string) (err error) {
+func (s *Session) ChannelMessagePin(channelID, messageID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("PUT", http.EndpointChannelMessagePin(channelID, messageID), nil, http.EndpointChannelMessagePin(channelID, ""))
+	_, err = s.RequestWithBucketID("PUT", http.EndpointChannelMessagePin(channelID, messageID), nil, http.EndpointChannelMessagePin(channelID, ""), options...)
 	return
 }
 
 // ChannelMessageUnpin unpins a message within a given channel.
 // channelID: The ID of a channel.
 // messageID: The ID of a message.
-func (s *Session) ChannelMessageUnpin(channelID, messageID string) (err error) {
+func (s *Session) ChannelMessageUnpin(channelID, messageID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelMessagePin(channelID, messageID), nil, http.EndpointChannelMessagePin(channelID, ""), options...)
+	return
+}
+
+// ChannelMessageAck acknowledges and marks the given message as read
+// channeld  : The ID of a Channel
+// messageID : the ID of a Message
+// lastToken : token returned by last ack
+func (s *Session) ChannelMessageAck(channelID, messageID, lastToken string, options ...RequestOption) (st *Ack, err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelMessagePin(channelID, messageID), nil, http.EndpointChannelMessagePin(channelID, ""))
+	body, err := s.RequestWithBucketID("POST", http.EndpointChannelMessageAck(channelID, messageID), &Ack{Token: lastToken}, http.EndpointChannelMessageAck(channelID, ""), options...)
+	if err != nil {
+		return
+	}
+
+	if s.StateEnabled {
+		s.State.channelAck(channelID, messageID)
+	}
+
+	err = unmarshal(body, &st)
 	return
 }
 
 // ChannelMessagesPinned returns an array of Message structures for pinned messages
 // within a given channel
 // channelID : The ID of a Channel.
-func (s *Session) ChannelMessagesPinned(channelID string) (st []*Message, err error) {
+func (s *Session) ChannelMessagesPinned(channelID string, options ...RequestOption) (st []*Message, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointChannelMessagesPins(channelID), nil, http.EndpointChannelMessagesPins(channelID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointChannelMessagesPins(channelID), nil, http.EndpointChannelMessagesPins(channelID), options...)
 
 	if err != nil {
 		return
@@ -1749,8 +2189,8 @@ func (s *Session) ChannelMessagesPinned(channelID string) (st []*Message, err er
 // channelID : The ID of a Channel.
 // name: The name of the file.
 // io.Reader : A reader for the file contents.
-func (s *Session) ChannelFileSend(channelID, name string, r io.Reader) (*Message, error) {
-	return s.ChannelMessageSendComplex(channelID, &MessageSend{File: &File{Name: name, Reader: r}})
+func (s *Session) ChannelFileSend(channelID, name string, r io.Reader, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageSendComplex(channelID, &MessageSend{File: &File{Name: name, Reader: r}}, options...)
 }
 
 // ChannelFileSendWithMessage sends a file to the given channel with an message.
@@ -1759,15 +2199,15 @@ func (s *Session) ChannelFileSend(channelID, name string, r io.Reader) (*Message
 // content: Optional Message content.
 // name: The name of the file.
 // io.Reader : A reader for the file contents.
-func (s *Session) ChannelFileSendWithMessage(channelID, content string, name string, r io.Reader) (*Message, error) {
-	return s.ChannelMessageSendComplex(channelID, &MessageSend{File: &File{Name: name, Reader: r}, Content: content})
+func (s *Session) ChannelFileSendWithMessage(channelID, content string, name string, r io.Reader, options ...RequestOption) (*Message, error) {
+	return s.ChannelMessageSendComplex(channelID, &MessageSend{File: &File{Name: name, Reader: r}, Content: content}, options...)
 }
 
 // ChannelInvites returns an array of Invite structures for the given channel
 // channelID   : The ID of a Channel
-func (s *Session) ChannelInvites(channelID string) (st []*Invite, err error) {
+func (s *Session) ChannelInvites(channelID string, options ...RequestOption) (st []*Invite, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointChannelInvites(channelID), nil, http.EndpointChannelInvites(channelID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointChannelInvites(channelID), nil, http.EndpointChannelInvites(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1779,7 +2219,7 @@ func (s *Session) ChannelInvites(channelID string) (st []*Invite, err error) {
 // ChannelInviteCreate creates a new invite for the given channel.
 // channelID   : The ID of a Channel
 // i           : An Invite struct with the values MaxAge, MaxUses and Temporary defined.
-func (s *Session) ChannelInviteCreate(channelID string, i Invite) (st *Invite, err error) {
+func (s *Session) ChannelInviteCreate(channelID string, i Invite, options ...RequestOption) (st *Invite, err error) {
 
 	data := struct {
 		MaxAge    int  `json:"max_age"`
@@ -1788,7 +2228,7 @@ func (s *Session) ChannelInviteCreate(channelID string, i Invite) (st *Invite, e
 		Unique    bool `json:"unique"`
 	}{i.MaxAge, i.MaxUses, i.Temporary, i.Unique}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointChannelInvites(channelID), data, http.EndpointChannelInvites(channelID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointChannelInvites(channelID), data, http.EndpointChannelInvites(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -1800,7 +2240,7 @@ func (s *Session) ChannelInviteCreate(channelID string, i Invite) (st *Invite, e
 // ChannelPermissionSet creates a Permission Override for the given channel.
 // NOTE: This func name may changed.  Using Set instead of Create because
 // you can both create a new override or update an override with this function.
-func (s *Session) ChannelPermissionSet(channelID, targetID string, targetType PermissionOverwriteType, allow, deny int64) (err error) {
+func (s *Session) ChannelPermissionSet(channelID, targetID string, targetType PermissionOverwriteType, allow, deny int64, options ...RequestOption) (err error) {
 
 	data := struct {
 		ID    string                  `json:"id"`
@@ -1809,15 +2249,15 @@ func (s *Session) ChannelPermissionSet(channelID, targetID string, targetType Pe
 		Deny  int64                   `json:"deny,string"`
 	}{targetID, targetType, allow, deny}
 
-	_, err = s.RequestWithBucketID("PUT", http.EndpointChannelPermission(channelID, targetID), data, http.EndpointChannelPermission(channelID, ""))
+	_, err = s.RequestWithBucketID("PUT", http.EndpointChannelPermission(channelID, targetID), data, http.EndpointChannelPermission(channelID, ""), options...)
 	return
 }
 
 // ChannelPermissionDelete deletes a specific permission override for the given channel.
 // NOTE: Name of this func may change.
-func (s *Session) ChannelPermissionDelete(channelID, targetID string) (err error) {
+func (s *Session) ChannelPermissionDelete(channelID, targetID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelPermission(channelID, targetID), nil, http.EndpointChannelPermission(channelID, ""))
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointChannelPermission(channelID, targetID), nil, http.EndpointChannelPermission(channelID, ""), options...)
 	return
 }
 
@@ -1825,11 +2265,11 @@ func (s *Session) ChannelPermissionDelete(channelID, targetID string) (err error
 // of the channel
 // channelID   : The ID of a Channel
 // messageID   : The ID of a Message
-func (s *Session) ChannelMessageCrosspost(channelID, messageID string) (st *Message, err error) {
+func (s *Session) ChannelMessageCrosspost(channelID, messageID string, options ...RequestOption) (st *Message, err error) {
 
 	endpoint := http.EndpointChannelMessageCrosspost(channelID, messageID)
 
-	body, err := s.RequestWithBucketID("POST", endpoint, nil, endpoint)
+	body, err := s.RequestWithBucketID("POST", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -1841,7 +2281,7 @@ func (s *Session) ChannelMessageCrosspost(channelID, messageID string) (st *Mess
 // ChannelNewsFollow follows a news channel in the targetID
 // channelID   : The ID of a News Channel
 // targetID    : The ID of a Channel where the News Channel should post to
-func (s *Session) ChannelNewsFollow(channelID, targetID string) (st *ChannelFollow, err error) {
+func (s *Session) ChannelNewsFollow(channelID, targetID string, options ...RequestOption) (st *ChannelFollow, err error) {
 
 	endpoint := http.EndpointChannelFollow(channelID)
 
@@ -1849,7 +2289,7 @@ func (s *Session) ChannelNewsFollow(channelID, targetID string) (st *ChannelFoll
 		WebhookChannelID string `json:"webhook_channel_id"`
 	}{targetID}
 
-	body, err := s.RequestWithBucketID("POST", endpoint, data, endpoint)
+	body, err := s.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -1864,9 +2304,9 @@ func (s *Session) ChannelNewsFollow(channelID, targetID string) (st *ChannelFoll
 
 // Invite returns an Invite structure of the given invite
 // inviteID : The invite code
-func (s *Session) Invite(inviteID string) (st *Invite, err error) {
+func (s *Session) Invite(inviteID string, options ...RequestOption) (st *Invite, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""), options...)
 	if err != nil {
 		return
 	}
@@ -1877,9 +2317,9 @@ func (s *Session) Invite(inviteID string) (st *Invite, err error) {
 
 // InviteWithCounts returns an Invite structure of the given invite including approximate member counts
 // inviteID : The invite code
-func (s *Session) InviteWithCounts(inviteID string) (st *Invite, err error) {
+func (s *Session) InviteWithCounts(inviteID string, options ...RequestOption) (st *Invite, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointInvite(inviteID)+"?with_counts=true", nil, http.EndpointInvite(""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointInvite(inviteID)+"?with_counts=true", nil, http.EndpointInvite(""), options...)
 	if err != nil {
 		return
 	}
@@ -1890,9 +2330,9 @@ func (s *Session) InviteWithCounts(inviteID string) (st *Invite, err error) {
 
 // InviteDelete deletes an existing invite
 // inviteID   : the code of an invite
-func (s *Session) InviteDelete(inviteID string) (st *Invite, err error) {
+func (s *Session) InviteDelete(inviteID string, options ...RequestOption) (st *Invite, err error) {
 
-	body, err := s.RequestWithBucketID("DELETE", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""))
+	body, err := s.RequestWithBucketID("DELETE", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""), options...)
 	if err != nil {
 		return
 	}
@@ -1903,9 +2343,9 @@ func (s *Session) InviteDelete(inviteID string) (st *Invite, err error) {
 
 // InviteAccept accepts an Invite to a Guild or Channel
 // inviteID : The invite code
-func (s *Session) InviteAccept(inviteID string) (st *Invite, err error) {
+func (s *Session) InviteAccept(inviteID string, options ...RequestOption) (st *Invite, err error) {
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""))
+	body, err := s.RequestWithBucketID("POST", http.EndpointInvite(inviteID), nil, http.EndpointInvite(""), options...)
 	if err != nil {
 		return
 	}
@@ -1919,9 +2359,9 @@ func (s *Session) InviteAccept(inviteID string) (st *Invite, err error) {
 // ------------------------------------------------------------------------------------------------
 
 // VoiceRegions returns the voice server regions
-func (s *Session) VoiceRegions() (st []*VoiceRegion, err error) {
+func (s *Session) VoiceRegions(options ...RequestOption) (st []*VoiceRegion, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointVoiceRegions, nil, http.EndpointVoiceRegions)
+	body, err := s.RequestWithBucketID("GET", http.EndpointVoiceRegions, nil, http.EndpointVoiceRegions, options...)
 	if err != nil {
 		return
 	}
@@ -1935,9 +2375,9 @@ func (s *Session) VoiceRegions() (st []*VoiceRegion, err error) {
 // ------------------------------------------------------------------------------------------------
 
 // Gateway returns the websocket Gateway address
-func (s *Session) Gateway() (gateway string, err error) {
+func (s *Session) Gateway(options ...RequestOption) (gateway string, err error) {
 
-	response, err := s.RequestWithBucketID("GET", http.EndpointGateway, nil, http.EndpointGateway)
+	response, err := s.RequestWithBucketID("GET", http.EndpointGateway, nil, http.EndpointGateway, options...)
 	if err != nil {
 		return
 	}
@@ -1963,9 +2403,9 @@ func (s *Session) Gateway() (gateway string, err error) {
 }
 
 // GatewayBot returns the websocket Gateway address and the recommended number of shards
-func (s *Session) GatewayBot() (st *GatewayBotResponse, err error) {
+func (s *Session) GatewayBot(options ...RequestOption) (st *GatewayBotResponse, err error) {
 
-	response, err := s.RequestWithBucketID("GET", http.EndpointGatewayBot, nil, http.EndpointGatewayBot)
+	response, err := s.RequestWithBucketID("GET", http.EndpointGatewayBot, nil, http.EndpointGatewayBot, options...)
 	if err != nil {
 		return
 	}
@@ -1990,14 +2430,14 @@ func (s *Session) GatewayBot() (st *GatewayBotResponse, err error) {
 // channelID: The ID of a Channel.
 // name     : The name of the webhook.
 // avatar   : The avatar of the webhook.
-func (s *Session) WebhookCreate(channelID, name, avatar string) (st *Webhook, err error) {
+func (s *Session) WebhookCreate(channelID, name, avatar string, options ...RequestOption) (st *Webhook, err error) {
 
 	data := struct {
 		Name   string `json:"name"`
 		Avatar string `json:"avatar,omitempty"`
 	}{name, avatar}
 
-	body, err := s.RequestWithBucketID("POST", http.EndpointChannelWebhooks(channelID), data, http.EndpointChannelWebhooks(channelID))
+	body, err := s.RequestWithBucketID("POST", http.EndpointChannelWebhooks(channelID), data, http.EndpointChannelWebhooks(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -2009,9 +2449,9 @@ func (s *Session) WebhookCreate(channelID, name, avatar string) (st *Webhook, er
 
 // ChannelWebhooks returns all webhooks for a given channel.
 // channelID: The ID of a channel.
-func (s *Session) ChannelWebhooks(channelID string) (st []*Webhook, err error) {
+func (s *Session) ChannelWebhooks(channelID string, options ...RequestOption) (st []*Webhook, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointChannelWebhooks(channelID), nil, http.EndpointChannelWebhooks(channelID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointChannelWebhooks(channelID), nil, http.EndpointChannelWebhooks(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -2023,9 +2463,9 @@ func (s *Session) ChannelWebhooks(channelID string) (st []*Webhook, err error) {
 
 // GuildWebhooks returns all webhooks for a given guild.
 // guildID: The ID of a Guild.
-func (s *Session) GuildWebhooks(guildID string) (st []*Webhook, err error) {
+func (s *Session) GuildWebhooks(guildID string, options ...RequestOption) (st []*Webhook, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointGuildWebhooks(guildID), nil, http.EndpointGuildWebhooks(guildID))
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildWebhooks(guildID), nil, http.EndpointGuildWebhooks(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -2037,9 +2477,9 @@ func (s *Session) GuildWebhooks(guildID string) (st []*Webhook, err error) {
 
 // Webhook returns a webhook for a given ID
 // webhookID: The ID of a webhook.
-func (s *Session) Webhook(webhookID string) (st *Webhook, err error) {
+func (s *Session) Webhook(webhookID string, options ...RequestOption) (st *Webhook, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointWebhook(webhookID), nil, http.EndpointWebhooks)
+	body, err := s.RequestWithBucketID("GET", http.EndpointWebhook(webhookID), nil, http.EndpointWebhooks, options...)
 	if err != nil {
 		return
 	}
@@ -2052,9 +2492,9 @@ func (s *Session) Webhook(webhookID string) (st *Webhook, err error) {
 // WebhookWithToken returns a webhook for a given ID
 // webhookID: The ID of a webhook.
 // token    : The auth token for the webhook.
-func (s *Session) WebhookWithToken(webhookID, token string) (st *Webhook, err error) {
+func (s *Session) WebhookWithToken(webhookID, token string, options ...RequestOption) (st *Webhook, err error) {
 
-	body, err := s.RequestWithBucketID("GET", http.EndpointWebhookToken(webhookID, token), nil, http.EndpointWebhookToken("", ""))
+	body, err := s.RequestWithBucketID("GET", http.EndpointWebhookToken(webhookID, token), nil, http.EndpointWebhookToken("", ""), options...)
 	if err != nil {
 		return
 	}
@@ -2068,7 +2508,7 @@ func (s *Session) WebhookWithToken(webhookID, token string) (st *Webhook, err er
 // webhookID: The ID of a webhook.
 // name     : The name of the webhook.
 // avatar   : The avatar of the webhook.
-func (s *Session) WebhookEdit(webhookID, name, avatar, channelID string) (st *Role, err error) {
+func (s *Session) WebhookEdit(webhookID, name, avatar, channelID string, options ...RequestOption) (st *Role, err error) {
 
 	data := struct {
 		Name      string `json:"name,omitempty"`
@@ -2076,7 +2516,7 @@ func (s *Session) WebhookEdit(webhookID, name, avatar, channelID string) (st *Ro
 		ChannelID string `json:"channel_id,omitempty"`
 	}{name, avatar, channelID}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointWebhook(webhookID), data, http.EndpointWebhooks)
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointWebhook(webhookID), data, http.EndpointWebhooks, options...)
 	if err != nil {
 		return
 	}
@@ -2091,14 +2531,14 @@ func (s *Session) WebhookEdit(webhookID, name, avatar, channelID string) (st *Ro
 // token    : The auth token for the webhook.
 // name     : The name of the webhook.
 // avatar   : The avatar of the webhook.
-func (s *Session) WebhookEditWithToken(webhookID, token, name, avatar string) (st *Role, err error) {
+func (s *Session) WebhookEditWithToken(webhookID, token, name, avatar string, options ...RequestOption) (st *Role, err error) {
 
 	data := struct {
 		Name   string `json:"name,omitempty"`
 		Avatar string `json:"avatar,omitempty"`
 	}{name, avatar}
 
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointWebhookToken(webhookID, token), data, http.EndpointWebhookToken("", ""))
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointWebhookToken(webhookID, token), data, http.EndpointWebhookToken("", ""), options...)
 	if err != nil {
 		return
 	}
@@ -2110,9 +2550,9 @@ func (s *Session) WebhookEditWithToken(webhookID, token, name, avatar string) (s
 
 // WebhookDelete deletes a webhook for a given ID
 // webhookID: The ID of a webhook.
-func (s *Session) WebhookDelete(webhookID string) (err error) {
+func (s *Session) WebhookDelete(webhookID string, options ...RequestOption) (err error) {
 
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointWebhook(webhookID), nil, http.EndpointWebhooks)
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointWebhook(webhookID), nil, http.EndpointWebhooks, options...)
 
 	return
 }
@@ -2120,9 +2560,9 @@ func (s *Session) WebhookDelete(webhookID string) (err error) {
 // WebhookDeleteWithToken deletes a webhook for a given ID with an auth token.
 // webhookID: The ID of a webhook.
 // token    : The auth token for the webhook.
-func (s *Session) WebhookDeleteWithToken(webhookID, token string) (st *Webhook, err error) {
+func (s *Session) WebhookDeleteWithToken(webhookID, token string, options ...RequestOption) (st *Webhook, err error) {
 
-	body, err := s.RequestWithBucketID("DELETE", http.EndpointWebhookToken(webhookID, token), nil, http.EndpointWebhookToken("", ""))
+	body, err := s.RequestWithBucketID("DELETE", http.EndpointWebhookToken(webhookID, token), nil, http.EndpointWebhookToken("", ""), options...)
 	if err != nil {
 		return
 	}
@@ -2132,7 +2572,7 @@ func (s *Session) WebhookDeleteWithToken(webhookID, token string) (st *Webhook,
 	return
 }
 
-func (s *Session) webhookExecute(webhookID, token string, wait bool, threadID string, data *WebhookParams) (st *Message, err error) {
+func (s *Session) webhookExecute(webhookID, token string, wait bool, threadID string, data *WebhookParams, options ...RequestOption) (st *Message, err error) {
 	uri := http.EndpointWebhookToken(webhookID, token)
 
 	v := url.Values{}
@@ -2154,9 +2594,9 @@ func (s *Session) webhookExecute(webhookID, token string, wait bool, threadID st
 			return st, encodeErr
 		}
 
-		response, err = s.request("POST", uri, contentType, body, uri, 0)
+		response, err = s.request("POST", uri, contentType, body, uri, 0, options...)
 	} else {
-		response, err = s.RequestWithBucketID("POST", uri, data, uri)
+		response, err = s.RequestWithBucketID("POST", uri, data, uri, options...)
 	}
 	if !wait || err != nil {
 		return
@@ -2170,8 +2610,8 @@ func (s *Session) webhookExecute(webhookID, token string, wait bool, threadID st
 // webhookID: The ID of a webhook.
 // token    : The auth token for the webhook
 // wait     : Waits for server confirmation of message send and ensures that the return struct is populated (it is nil otherwise)
-func (s *Session) WebhookExecute(webhookID, token string, wait bool, data *WebhookParams) (st *Message, err error) {
-	return s.webhookExecute(webhookID, token, wait, "", data)
+func (s *Session) WebhookExecute(webhookID, token string, wait bool, data *WebhookParams, options ...RequestOption) (st *Message, err error) {
+	return s.webhookExecute(webhookID, token, wait, "", data, options...)
 }
 
 // WebhookThreadExecute executes a webhook in a thread.
@@ -2179,18 +2619,18 @@ func (s *Session) WebhookExecute(webhookID, token string, wait bool, data *Webho
 // token    : The auth token for the webhook
 // wait     : Waits for server confirmation of message send and ensures that the return struct is populated (it is nil otherwise)
 // threadID :	Sends a message to the specified thread within a webhook's channel. The thread will automatically be unarchived.
-func (s *Session) WebhookThreadExecute(webhookID, token string, wait bool, threadID string, data *WebhookParams) (st *Message, err error) {
-	return s.webhookExecute(webhookID, token, wait, threadID, data)
+func (s *Session) WebhookThreadExecute(webhookID, token string, wait bool, threadID string, data *WebhookParams, options ...RequestOption) (st *Message, err error) {
+	return s.webhookExecute(webhookID, token, wait, threadID, data, options...)
 }
 
 // WebhookMessage gets a webhook message.
 // webhookID : The ID of a webhook
 // token     : The auth token for the webhook
 // messageID : The ID of message to get
-func (s *Session) WebhookMessage(webhookID, token, messageID string) (message *Message, err error) {
+func (s *Session) WebhookMessage(webhookID, token, messageID string, options ...RequestOption) (message *Message, err error) {
 	uri := http.EndpointWebhookMessage(webhookID, token, messageID)
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointWebhookToken("", ""))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointWebhookToken("", ""), options...)
 	if err != nil {
 		return
 	}
@@ -2204,7 +2644,7 @@ func (s *Session) WebhookMessage(webhookID, token, messageID string) (message *M
 // webhookID : The ID of a webhook
 // token     : The auth token for the webhook
 // messageID : The ID of message to edit
-func (s *Session) WebhookMessageEdit(webhookID, token, messageID string, data *WebhookEdit) (st *Message, err error) {
+func (s *Session) WebhookMessageEdit(webhookID, token, messageID string, data *WebhookEdit, options ...RequestOption) (st *Message, err error) {
 	uri := http.EndpointWebhookMessage(webhookID, token, messageID)
 
 	var response []byte
@@ -2214,12 +2654,12 @@ func (s *Session) WebhookMessageEdit(webhookID, token, messageID string, data *W
 			return nil, err
 		}
 
-		response, err = s.request("PATCH", uri, contentType, body, uri, 0)
+		response, err = s.request("PATCH", uri, contentType, body, uri, 0, options...)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		response, err = s.RequestWithBucketID("PATCH", uri, data, http.EndpointWebhookToken("", ""))
+		response, err = s.RequestWithBucketID("PATCH", uri, data, http.EndpointWebhookToken("", ""), options...)
 
 		if err != nil {
 			return nil, err
@@ -2234,10 +2674,10 @@ func (s *Session) WebhookMessageEdit(webhookID, token, messageID string, data *W
 // webhookID : The ID of a webhook
 // token     : The auth token for the webhook
 // messageID : The ID of a message to edit
-func (s *Session) WebhookMessageDelete(webhookID, token, messageID string) (err error) {
+func (s *Session) WebhookMessageDelete(webhookID, token, messageID string, options ...RequestOption) (err error) {
 	uri := http.EndpointWebhookMessage(webhookID, token, messageID)
 
-	_, err = s.RequestWithBucketID("DELETE", uri, nil, http.EndpointWebhookToken("", ""))
+	_, err = s.RequestWithBucketID("DELETE", uri, nil, http.EndpointWebhookToken("", ""), options...)
 	return
 }
 
@@ -2245,11 +2685,11 @@ func (s *Session) WebhookMessageDelete(webhookID, token, messageID string) (err
 // channelID : The channel ID.
 // messageID : The message ID.
 // emojiID   : Either the unicode emoji for the reaction, or a guild emoji identifier.
-func (s *Session) MessageReactionAdd(channelID, messageID, emojiID string) error {
+func (s *Session) MessageReactionAdd(channelID, messageID, emojiID string, options ...RequestOption) error {
 
 	// emoji such as  #⃣ need to have # escaped
 	emojiID = strings.Replace(emojiID, "#", "%23", -1)
-	_, err := s.RequestWithBucketID("PUT", http.EndpointMessageReaction(channelID, messageID, emojiID, "@me"), nil, http.EndpointMessageReaction(channelID, "", "", ""))
+	_, err := s.RequestWithBucketID("PUT", http.EndpointMessageReaction(channelID, messageID, emojiID, "@me"), nil, http.EndpointMessageReaction(channelID, "", "", ""), options...)
 
 	return err
 }
@@ -2259,11 +2699,11 @@ func (s *Session) MessageReactionAdd(channelID, messageID, emojiID string) error
 // messageID : The message ID.
 // emojiID   : Either the unicode emoji for the reaction, or a guild emoji identifier.
 // userID	 : @me or ID of the user to delete the reaction for.
-func (s *Session) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
+func (s *Session) MessageReactionRemove(channelID, messageID, emojiID, userID string, options ...RequestOption) error {
 
 	// emoji such as  #⃣ need to have # escaped
 	emojiID = strings.Replace(emojiID, "#", "%23", -1)
-	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReaction(channelID, messageID, emojiID, userID), nil, http.EndpointMessageReaction(channelID, "", "", ""))
+	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReaction(channelID, messageID, emojiID, userID), nil, http.EndpointMessageReaction(channelID, "", "", ""), options...)
 
 	return err
 }
@@ -2271,9 +2711,9 @@ func (s *Session) MessageReactionRemove(channelID, messageID, emojiID, userID st
 // MessageReactionsRemoveAll deletes all reactions from a message
 // channelID : The channel ID
 // messageID : The message ID.
-func (s *Session) MessageReactionsRemoveAll(channelID, messageID string) error {
+func (s *Session) MessageReactionsRemoveAll(channelID, messageID string, options ...RequestOption) error {
 
-	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReactionsAll(channelID, messageID), nil, http.EndpointMessageReactionsAll(channelID, messageID))
+	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReactionsAll(channelID, messageID), nil, http.EndpointMessageReactionsAll(channelID, messageID), options...)
 
 	return err
 }
@@ -2282,11 +2722,11 @@ func (s *Session) MessageReactionsRemoveAll(channelID, messageID string) error {
 // channelID : The channel ID
 // messageID : The message ID
 // emojiID   : The emoji ID
-func (s *Session) MessageReactionsRemoveEmoji(channelID, messageID, emojiID string) error {
+func (s *Session) MessageReactionsRemoveEmoji(channelID, messageID, emojiID string, options ...RequestOption) error {
 
 	// emoji such as  #⃣ need to have # escaped
 	emojiID = strings.Replace(emojiID, "#", "%23", -1)
-	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReactions(channelID, messageID, emojiID), nil, http.EndpointMessageReactions(channelID, messageID, emojiID))
+	_, err := s.RequestWithBucketID("DELETE", http.EndpointMessageReactions(channelID, messageID, emojiID), nil, http.EndpointMessageReactions(channelID, messageID, emojiID), options...)
 
 	return err
 }
@@ -2298,7 +2738,7 @@ func (s *Session) MessageReactionsRemoveEmoji(channelID, messageID, emojiID stri
 // limit    : max number of users to return (max 100)
 // beforeID  : If provided all reactions returned will be before given ID.
 // afterID   : If provided all reactions returned will be after given ID.
-func (s *Session) MessageReactions(channelID, messageID, emojiID string, limit int, beforeID, afterID string) (st []*User, err error) {
+func (s *Session) MessageReactions(channelID, messageID, emojiID string, limit int, beforeID, afterID string, options ...RequestOption) (st []*User, err error) {
 	// emoji such as  #⃣ need to have # escaped
 	emojiID = strings.Replace(emojiID, "#", "%23", -1)
 	uri := http.EndpointMessageReactions(channelID, messageID, emojiID)
@@ -2320,7 +2760,7 @@ func (s *Session) MessageReactions(channelID, messageID, emojiID string, limit i
 		uri += "?" + v.Encode()
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointMessageReaction(channelID, "", "", ""))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointMessageReaction(channelID, "", "", ""), options...)
 	if err != nil {
 		return
 	}
@@ -2337,10 +2777,10 @@ func (s *Session) MessageReactions(channelID, messageID, emojiID string, limit i
 // channelID : Channel to create thread in
 // messageID : Message to start thread from
 // data : Parameters of the thread
-func (s *Session) MessageThreadStartComplex(channelID, messageID string, data *ThreadStart) (ch *Channel, err error) {
+func (s *Session) MessageThreadStartComplex(channelID, messageID string, data *ThreadStart, options ...RequestOption) (ch *Channel, err error) {
 	endpoint := http.EndpointChannelMessageThread(channelID, messageID)
 	var body []byte
-	body, err = s.RequestWithBucketID("POST", endpoint, data, endpoint)
+	body, err = s.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2354,20 +2794,20 @@ func (s *Session) MessageThreadStartComplex(channelID, messageID string, data *T
 // messageID       : Message to start thread from
 // name            : Name of the thread
 // archiveDuration : Auto archive duration (in minutes)
-func (s *Session) MessageThreadStart(channelID, messageID string, name string, archiveDuration int) (ch *Channel, err error) {
+func (s *Session) MessageThreadStart(channelID, messageID string, name string, archiveDuration int, options ...RequestOption) (ch *Channel, err error) {
 	return s.MessageThreadStartComplex(channelID, messageID, &ThreadStart{
 		Name:                name,
 		AutoArchiveDuration: archiveDuration,
-	})
+	}, options...)
 }
 
 // ThreadStartComplex creates a new thread.
 // channelID : Channel to create thread in
 // data : Parameters of the thread
-func (s *Session) ThreadStartComplex(channelID string, data *ThreadStart) (ch *Channel, err error) {
+func (s *Session) ThreadStartComplex(channelID string, data *ThreadStart, options ...RequestOption) (ch *Channel, err error) {
 	endpoint := http.EndpointChannelThreads(channelID)
 	var body []byte
-	body, err = s.RequestWithBucketID("POST", endpoint, data, endpoint)
+	body, err = s.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2380,47 +2820,123 @@ func (s *Session) ThreadStartComplex(channelID string, data *ThreadStart) (ch *C
 // channelID       : Channel to create thread in
 // name            : Name of the thread
 // archiveDuration : Auto archive duration (in minutes)
-func (s *Session) ThreadStart(channelID, name string, typ ChannelType, archiveDuration int) (ch *Channel, err error) {
+func (s *Session) ThreadStart(channelID, name string, typ ChannelType, archiveDuration int, options ...RequestOption) (ch *Channel, err error) {
 	return s.ThreadStartComplex(channelID, &ThreadStart{
 		Name:                name,
 		Type:                typ,
 		AutoArchiveDuration: archiveDuration,
-	})
+	}, options...)
+}
+
+// ForumThreadStartComplex creates a new post in a forum channel, which is a
+// thread with an initial message.
+// channelID   : Forum channel to create the post in
+// threadData  : Parameters of the thread
+// messageData : Parameters of the initial message, including its files
+func (s *Session) ForumThreadStartComplex(channelID string, threadData *ThreadStart, messageData *MessageSend, options ...RequestOption) (th *Channel, err error) {
+	if messageData == nil {
+		err = ErrForumPostNoMessage
+		return
+	}
+
+	for _, embed := range messageData.Embeds {
+		if embed.Type == "" {
+			embed.Type = "rich"
+		}
+	}
+
+	data := struct {
+		*ThreadStart
+		Message *MessageSend `json:"message"`
+	}{threadData, messageData}
+
+	endpoint := http.EndpointChannelThreads(channelID)
+
+	var response []byte
+	if len(messageData.Files) > 0 {
+		contentType, body, encodeErr := MultipartBodyWithJSON(data, messageData.Files)
+		if encodeErr != nil {
+			return th, encodeErr
+		}
+
+		response, err = s.request("POST", endpoint, contentType, body, endpoint, 0, options...)
+	} else {
+		response, err = s.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
+	}
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(response, &th)
+	return
+}
+
+// ForumThreadStart creates a new post in a forum channel.
+// channelID       : Forum channel to create the post in
+// name            : Name of the post
+// archiveDuration : Auto archive duration (in minutes)
+// content         : Content of the initial message
+func (s *Session) ForumThreadStart(channelID, name string, archiveDuration int, content string, options ...RequestOption) (th *Channel, err error) {
+	return s.ForumThreadStartComplex(channelID, &ThreadStart{
+		Name:                name,
+		AutoArchiveDuration: archiveDuration,
+	}, &MessageSend{Content: content}, options...)
+}
+
+// ThreadTagsEdit replaces the tags applied to a post in a forum channel.
+// threadID : The ID of a thread in a forum channel
+// tagIDs   : The IDs of the forum tags to apply, at most 5
+func (s *Session) ThreadTagsEdit(threadID string, tagIDs []string, options ...RequestOption) (st *Channel, err error) {
+	if tagIDs == nil {
+		tagIDs = []string{}
+	}
+
+	data := struct {
+		AppliedTags []string `json:"applied_tags"`
+	}{tagIDs}
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointChannel(threadID), data, http.EndpointChannel(threadID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
 }
 
 // ThreadJoin adds current user to a thread
-func (s *Session) ThreadJoin(id string) error {
+func (s *Session) ThreadJoin(id string, options ...RequestOption) error {
 	endpoint := http.EndpointThreadMember(id, "@me")
-	_, err := s.RequestWithBucketID("PUT", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("PUT", endpoint, nil, endpoint, options...)
 	return err
 }
 
 // ThreadLeave removes current user to a thread
-func (s *Session) ThreadLeave(id string) error {
+func (s *Session) ThreadLeave(id string, options ...RequestOption) error {
 	endpoint := http.EndpointThreadMember(id, "@me")
-	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint, options...)
 	return err
 }
 
 // ThreadMemberAdd adds another member to a thread
-func (s *Session) ThreadMemberAdd(threadID, memberID string) error {
+func (s *Session) ThreadMemberAdd(threadID, memberID string, options ...RequestOption) error {
 	endpoint := http.EndpointThreadMember(threadID, memberID)
-	_, err := s.RequestWithBucketID("PUT", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("PUT", endpoint, nil, endpoint, options...)
 	return err
 }
 
 // ThreadMemberRemove removes another member from a thread
-func (s *Session) ThreadMemberRemove(threadID, memberID string) error {
+func (s *Session) ThreadMemberRemove(threadID, memberID string, options ...RequestOption) error {
 	endpoint := http.EndpointThreadMember(threadID, memberID)
-	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint, options...)
 	return err
 }
 
 // ThreadMember returns thread member object for the specified member of a thread
-func (s *Session) ThreadMember(threadID, memberID string) (member *ThreadMember, err error) {
+func (s *Session) ThreadMember(threadID, memberID string, options ...RequestOption) (member *ThreadMember, err error) {
 	endpoint := http.EndpointThreadMember(threadID, memberID)
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 
 	if err != nil {
 		return
@@ -2431,9 +2947,9 @@ func (s *Session) ThreadMember(threadID, memberID string) (member *ThreadMember,
 }
 
 // ThreadMembers returns all members of specified thread.
-func (s *Session) ThreadMembers(threadID string) (members []*ThreadMember, err error) {
+func (s *Session) ThreadMembers(threadID string, options ...RequestOption) (members []*ThreadMember, err error) {
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", http.EndpointThreadMembers(threadID), nil, http.EndpointThreadMembers(threadID))
+	body, err = s.RequestWithBucketID("GET", http.EndpointThreadMembers(threadID), nil, http.EndpointThreadMembers(threadID), options...)
 
 	if err != nil {
 		return
@@ -2444,9 +2960,9 @@ func (s *Session) ThreadMembers(threadID string) (members []*ThreadMember, err e
 }
 
 // ThreadsActive returns all active threads for specified channel.
-func (s *Session) ThreadsActive(channelID string) (threads *ThreadsList, err error) {
+func (s *Session) ThreadsActive(channelID string, options ...RequestOption) (threads *ThreadsList, err error) {
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", http.EndpointChannelActiveThreads(channelID), nil, http.EndpointChannelActiveThreads(channelID))
+	body, err = s.RequestWithBucketID("GET", http.EndpointChannelActiveThreads(channelID), nil, http.EndpointChannelActiveThreads(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -2456,9 +2972,9 @@ func (s *Session) ThreadsActive(channelID string) (threads *ThreadsList, err err
 }
 
 // GuildThreadsActive returns all active threads for specified guild.
-func (s *Session) GuildThreadsActive(guildID string) (threads *ThreadsList, err error) {
+func (s *Session) GuildThreadsActive(guildID string, options ...RequestOption) (threads *ThreadsList, err error) {
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", http.EndpointGuildActiveThreads(guildID), nil, http.EndpointGuildActiveThreads(guildID))
+	body, err = s.RequestWithBucketID("GET", http.EndpointGuildActiveThreads(guildID), nil, http.EndpointGuildActiveThreads(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -2470,11 +2986,11 @@ func (s *Session) GuildThreadsActive(guildID string) (threads *ThreadsList, err
 // ThreadsArchived returns archived threads for specified channel.
 // before : If specified returns only threads before the timestamp
 // limit  : Optional maximum amount of threads to return.
-func (s *Session) ThreadsArchived(channelID string, before *time.Time, limit int) (threads *ThreadsList, err error) {
+func (s *Session) ThreadsArchived(channelID string, before *time.Time, limit int, options ...RequestOption) (threads *ThreadsList, err error) {
 	endpoint := http.EndpointChannelPublicArchivedThreads(channelID)
 	v := url.Values{}
 	if before != nil {
-		v.Set("before", before.Format(time.RFC3339))
+		v.Set("before", before.Format(time.RFC3339Nano))
 	}
 
 	if limit > 0 {
@@ -2486,7 +3002,7 @@ func (s *Session) ThreadsArchived(channelID string, before *time.Time, limit int
 	}
 
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2498,11 +3014,11 @@ func (s *Session) ThreadsArchived(channelID string, before *time.Time, limit int
 // ThreadsPrivateArchived returns archived private threads for specified channel.
 // before : If specified returns only threads before the timestamp
 // limit  : Optional maximum amount of threads to return.
-func (s *Session) ThreadsPrivateArchived(channelID string, before *time.Time, limit int) (threads *ThreadsList, err error) {
+func (s *Session) ThreadsPrivateArchived(channelID string, before *time.Time, limit int, options ...RequestOption) (threads *ThreadsList, err error) {
 	endpoint := http.EndpointChannelPrivateArchivedThreads(channelID)
 	v := url.Values{}
 	if before != nil {
-		v.Set("before", before.Format(time.RFC3339))
+		v.Set("before", before.Format(time.RFC3339Nano))
 	}
 
 	if limit > 0 {
@@ -2513,7 +3029,7 @@ func (s *Session) ThreadsPrivateArchived(channelID string, before *time.Time, li
 		endpoint += "?" + v.Encode()
 	}
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2525,11 +3041,11 @@ func (s *Session) ThreadsPrivateArchived(channelID string, before *time.Time, li
 // ThreadsPrivateJoinedArchived returns archived joined private threads for specified channel.
 // before : If specified returns only threads before the timestamp
 // limit  : Optional maximum amount of threads to return.
-func (s *Session) ThreadsPrivateJoinedArchived(channelID string, before *time.Time, limit int) (threads *ThreadsList, err error) {
+func (s *Session) ThreadsPrivateJoinedArchived(channelID string, before *time.Time, limit int, options ...RequestOption) (threads *ThreadsList, err error) {
 	endpoint := http.EndpointChannelJoinedPrivateArchivedThreads(channelID)
 	v := url.Values{}
 	if before != nil {
-		v.Set("before", before.Format(time.RFC3339))
+		v.Set("before", before.Format(time.RFC3339Nano))
 	}
 
 	if limit > 0 {
@@ -2540,7 +3056,7 @@ func (s *Session) ThreadsPrivateJoinedArchived(channelID string, before *time.Ti
 		endpoint += "?" + v.Encode()
 	}
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2557,13 +3073,13 @@ func (s *Session) ThreadsPrivateJoinedArchived(channelID string, before *time.Ti
 // appID       : The application ID.
 // guildID     : Guild ID to create guild-specific application command. If empty - creates global application command.
 // cmd         : New application command data.
-func (s *Session) ApplicationCommandCreate(appID string, guildID string, cmd *ApplicationCommand) (ccmd *ApplicationCommand, err error) {
+func (s *Session) ApplicationCommandCreate(appID string, guildID string, cmd *ApplicationCommand, options ...RequestOption) (ccmd *ApplicationCommand, err error) {
 	endpoint := http.EndpointApplicationGlobalCommands(appID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommands(appID, guildID)
 	}
 
-	body, err := s.RequestWithBucketID("POST", endpoint, *cmd, endpoint)
+	body, err := s.RequestWithBucketID("POST", endpoint, *cmd, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2578,13 +3094,13 @@ func (s *Session) ApplicationCommandCreate(appID string, guildID string, cmd *Ap
 // cmdID       : Application command ID to edit.
 // guildID     : Guild ID to edit guild-specific application command. If empty - edits global application command.
 // cmd         : Updated application command data.
-func (s *Session) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *ApplicationCommand) (updated *ApplicationCommand, err error) {
+func (s *Session) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *ApplicationCommand, options ...RequestOption) (updated *ApplicationCommand, err error) {
 	endpoint := http.EndpointApplicationGlobalCommand(appID, cmdID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommand(appID, guildID, cmdID)
 	}
 
-	body, err := s.RequestWithBucketID("PATCH", endpoint, *cmd, endpoint)
+	body, err := s.RequestWithBucketID("PATCH", endpoint, *cmd, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2597,13 +3113,13 @@ func (s *Session) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *Appl
 // ApplicationCommandBulkOverwrite Creates commands overwriting existing commands. Returns a list of commands.
 // appID    : The application ID.
 // commands : The commands to create.
-func (s *Session) ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*ApplicationCommand) (createdCommands []*ApplicationCommand, err error) {
+func (s *Session) ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*ApplicationCommand, options ...RequestOption) (createdCommands []*ApplicationCommand, err error) {
 	endpoint := http.EndpointApplicationGlobalCommands(appID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommands(appID, guildID)
 	}
 
-	body, err := s.RequestWithBucketID("PUT", endpoint, commands, endpoint)
+	body, err := s.RequestWithBucketID("PUT", endpoint, commands, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2617,13 +3133,13 @@ func (s *Session) ApplicationCommandBulkOverwrite(appID string, guildID string,
 // appID       : The application ID.
 // cmdID       : Application command ID to delete.
 // guildID     : Guild ID to delete guild-specific application command. If empty - deletes global application command.
-func (s *Session) ApplicationCommandDelete(appID, guildID, cmdID string) error {
+func (s *Session) ApplicationCommandDelete(appID, guildID, cmdID string, options ...RequestOption) error {
 	endpoint := http.EndpointApplicationGlobalCommand(appID, cmdID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommand(appID, guildID, cmdID)
 	}
 
-	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint, options...)
 
 	return err
 }
@@ -2632,13 +3148,13 @@ func (s *Session) ApplicationCommandDelete(appID, guildID, cmdID string) error {
 // appID       : The application ID.
 // cmdID       : Application command ID.
 // guildID     : Guild ID to retrieve guild-specific application command. If empty - retrieves global application command.
-func (s *Session) ApplicationCommand(appID, guildID, cmdID string) (cmd *ApplicationCommand, err error) {
+func (s *Session) ApplicationCommand(appID, guildID, cmdID string, options ...RequestOption) (cmd *ApplicationCommand, err error) {
 	endpoint := http.EndpointApplicationGlobalCommand(appID, cmdID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommand(appID, guildID, cmdID)
 	}
 
-	body, err := s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err := s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2651,13 +3167,13 @@ func (s *Session) ApplicationCommand(appID, guildID, cmdID string) (cmd *Applica
 // ApplicationCommands retrieves all commands in application.
 // appID       : The application ID.
 // guildID     : Guild ID to retrieve all guild-specific application commands. If empty - retrieves global application commands.
-func (s *Session) ApplicationCommands(appID, guildID string) (cmd []*ApplicationCommand, err error) {
+func (s *Session) ApplicationCommands(appID, guildID string, options ...RequestOption) (cmd []*ApplicationCommand, err error) {
 	endpoint := http.EndpointApplicationGlobalCommands(appID)
 	if guildID != "" {
 		endpoint = http.EndpointApplicationGuildCommands(appID, guildID)
 	}
 
-	body, err := s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err := s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2670,11 +3186,11 @@ func (s *Session) ApplicationCommands(appID, guildID string) (cmd []*Application
 // GuildApplicationCommandsPermissions returns permissions for application commands in a guild.
 // appID       : The application ID
 // guildID     : Guild ID to retrieve application commands permissions for.
-func (s *Session) GuildApplicationCommandsPermissions(appID, guildID string) (permissions []*GuildApplicationCommandPermissions, err error) {
+func (s *Session) GuildApplicationCommandsPermissions(appID, guildID string, options ...RequestOption) (permissions []*GuildApplicationCommandPermissions, err error) {
 	endpoint := http.EndpointApplicationCommandsGuildPermissions(appID, guildID)
 
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2687,11 +3203,11 @@ func (s *Session) GuildApplicationCommandsPermissions(appID, guildID string) (pe
 // appID       : The Application ID
 // guildID     : The guild ID containing the application command
 // cmdID       : The command ID to retrieve the permissions of
-func (s *Session) ApplicationCommandPermissions(appID, guildID, cmdID string) (permissions *GuildApplicationCommandPermissions, err error) {
+func (s *Session) ApplicationCommandPermissions(appID, guildID, cmdID string, options ...RequestOption) (permissions *GuildApplicationCommandPermissions, err error) {
 	endpoint := http.EndpointApplicationCommandPermissions(appID, guildID, cmdID)
 
 	var body []byte
-	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint)
+	body, err = s.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
 	if err != nil {
 		return
 	}
@@ -2705,10 +3221,10 @@ func (s *Session) ApplicationCommandPermissions(appID, guildID, cmdID string) (p
 // guildID     : The guild ID containing the application command
 // cmdID       : The command ID to edit the permissions of
 // permissions : An object containing a list of permissions for the application command
-func (s *Session) ApplicationCommandPermissionsEdit(appID, guildID, cmdID string, permissions *ApplicationCommandPermissionsList) (err error) {
+func (s *Session) ApplicationCommandPermissionsEdit(appID, guildID, cmdID string, permissions *ApplicationCommandPermissionsList, options ...RequestOption) (err error) {
 	endpoint := http.EndpointApplicationCommandPermissions(appID, guildID, cmdID)
 
-	_, err = s.RequestWithBucketID("PUT", endpoint, permissions, endpoint)
+	_, err = s.RequestWithBucketID("PUT", endpoint, permissions, endpoint, options...)
 	return
 }
 
@@ -2716,17 +3232,17 @@ func (s *Session) ApplicationCommandPermissionsEdit(appID, guildID, cmdID string
 // appID       : The Application ID
 // guildID     : The guild ID to batch edit commands of
 // permissions : A list of permissions paired with a command ID, guild ID, and application ID per application command
-func (s *Session) ApplicationCommandPermissionsBatchEdit(appID, guildID string, permissions []*GuildApplicationCommandPermissions) (err error) {
+func (s *Session) ApplicationCommandPermissionsBatchEdit(appID, guildID string, permissions []*GuildApplicationCommandPermissions, options ...RequestOption) (err error) {
 	endpoint := http.EndpointApplicationCommandsGuildPermissions(appID, guildID)
 
-	_, err = s.RequestWithBucketID("PUT", endpoint, permissions, endpoint)
+	_, err = s.RequestWithBucketID("PUT", endpoint, permissions, endpoint, options...)
 	return
 }
 
 // InteractionRespond creates the response to an interaction.
 // interaction : Interaction instance.
 // resp        : Response message data.
-func (s *Session) InteractionRespond(interaction *Interaction, resp *InteractionResponse) (err error) {
+func (s *Session) InteractionRespond(interaction *Interaction, resp *InteractionResponse, options ...RequestOption) (err error) {
 	endpoint := http.EndpointInteractionResponse(interaction.ID, interaction.Token)
 
 	if resp.Data != nil && len(resp.Data.Files) > 0 {
@@ -2735,9 +3251,9 @@ func (s *Session) InteractionRespond(interaction *Interaction, resp *Interaction
 			return err
 		}
 
-		_, err = s.request("POST", endpoint, contentType, body, endpoint, 0)
+		_, err = s.request("POST", endpoint, contentType, body, endpoint, 0, options...)
 	} else {
-		_, err = s.RequestWithBucketID("POST", endpoint, *resp, endpoint)
+		_, err = s.RequestWithBucketID("POST", endpoint, *resp, endpoint, options...)
 	}
 	return err
 }
@@ -2745,25 +3261,25 @@ func (s *Session) InteractionRespond(interaction *Interaction, resp *Interaction
 // InteractionResponse gets the response to an interaction.
 // appID       : The application ID.
 // interaction : Interaction instance.
-func (s *Session) InteractionResponse(appID string, interaction *Interaction) (*Message, error) {
-	return s.WebhookMessage(appID, interaction.Token, "@original")
+func (s *Session) InteractionResponse(appID string, interaction *Interaction, options ...RequestOption) (*Message, error) {
+	return s.WebhookMessage(appID, interaction.Token, "@original", options...)
 }
 
 // InteractionResponseEdit edits the response to an interaction.
 // appID       : The application ID.
 // interaction : Interaction instance.
 // newresp     : Updated response message data.
-func (s *Session) InteractionResponseEdit(appID string, interaction *Interaction, newresp *WebhookEdit) (*Message, error) {
-	return s.WebhookMessageEdit(appID, interaction.Token, "@original", newresp)
+func (s *Session) InteractionResponseEdit(appID string, interaction *Interaction, newresp *WebhookEdit, options ...RequestOption) (*Message, error) {
+	return s.WebhookMessageEdit(appID, interaction.Token, "@original", newresp, options...)
 }
 
 // InteractionResponseDelete deletes the response to an interaction.
 // appID       : The application ID.
 // interaction : Interaction instance.
-func (s *Session) InteractionResponseDelete(appID string, interaction *Interaction) error {
+func (s *Session) InteractionResponseDelete(appID string, interaction *Interaction, options ...RequestOption) error {
 	endpoint := http.EndpointInteractionResponseActions(appID, interaction.Token)
 
-	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint)
+	_, err := s.RequestWithBucketID("DELETE", endpoint, nil, endpoint, options...)
 
 	return err
 }
@@ -2773,8 +3289,8 @@ func (s *Session) InteractionResponseDelete(appID string, interaction *Interacti
 // interaction : Interaction instance.
 // wait        : Waits for server confirmation of message send and ensures that the return struct is populated (it is nil otherwise)
 // data        : Data of the message to send.
-func (s *Session) FollowupMessageCreate(appID string, interaction *Interaction, wait bool, data *WebhookParams) (*Message, error) {
-	return s.WebhookExecute(appID, interaction.Token, wait, data)
+func (s *Session) FollowupMessageCreate(appID string, interaction *Interaction, wait bool, data *WebhookParams, options ...RequestOption) (*Message, error) {
+	return s.WebhookExecute(appID, interaction.Token, wait, data, options...)
 }
 
 // FollowupMessageEdit edits a followup message of an interaction.
@@ -2782,16 +3298,16 @@ func (s *Session) FollowupMessageCreate(appID string, interaction *Interaction,
 // interaction : Interaction instance.
 // messageID   : The followup message ID.
 // data        : Data to update the message
-func (s *Session) FollowupMessageEdit(appID string, interaction *Interaction, messageID string, data *WebhookEdit) (*Message, error) {
-	return s.WebhookMessageEdit(appID, interaction.Token, messageID, data)
+func (s *Session) FollowupMessageEdit(appID string, interaction *Interaction, messageID string, data *WebhookEdit, options ...RequestOption) (*Message, error) {
+	return s.WebhookMessageEdit(appID, interaction.Token, messageID, data, options...)
 }
 
 // FollowupMessageDelete deletes a followup message of an interaction.
 // appID       : The application ID.
 // interaction : Interaction instance.
 // messageID   : The followup message ID.
-func (s *Session) FollowupMessageDelete(appID string, interaction *Interaction, messageID string) error {
-	return s.WebhookMessageDelete(appID, interaction.Token, messageID)
+func (s *Session) FollowupMessageDelete(appID string, interaction *Interaction, messageID string, options ...RequestOption) error {
+	return s.WebhookMessageDelete(appID, interaction.Token, messageID, options...)
 }
 
 // ------------------------------------------------------------------------------------------------
@@ -2801,13 +3317,13 @@ func (s *Session) FollowupMessageDelete(appID string, interaction *Interaction,
 // GuildScheduledEvents returns an array of GuildScheduledEvent for a guild
 // guildID        : The ID of a Guild
 // userCount      : Whether to include the user count in the response
-func (s *Session) GuildScheduledEvents(guildID string, userCount bool) (st []*GuildScheduledEvent, err error) {
+func (s *Session) GuildScheduledEvents(guildID string, userCount bool, options ...RequestOption) (st []*GuildScheduledEvent, err error) {
 	uri := http.EndpointGuildScheduledEvents(guildID)
 	if userCount {
 		uri += "?with_user_count=true"
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEvents(guildID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEvents(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -2820,13 +3336,13 @@ func (s *Session) GuildScheduledEvents(guildID string, userCount bool) (st []*Gu
 // guildID        : The ID of a Guild
 // eventID        : The ID of the event
 // userCount      : Whether to include the user count in the response
-func (s *Session) GuildScheduledEvent(guildID, eventID string, userCount bool) (st *GuildScheduledEvent, err error) {
+func (s *Session) GuildScheduledEvent(guildID, eventID string, userCount bool, options ...RequestOption) (st *GuildScheduledEvent, err error) {
 	uri := http.EndpointGuildScheduledEvent(guildID, eventID)
 	if userCount {
 		uri += "?with_user_count=true"
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEvent(guildID, eventID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEvent(guildID, eventID), options...)
 	if err != nil {
 		return
 	}
@@ -2838,8 +3354,8 @@ func (s *Session) GuildScheduledEvent(guildID, eventID string, userCount bool) (
 // GuildScheduledEventCreate creates a GuildScheduledEvent for a guild and returns it
 // guildID   : The ID of a Guild
 // eventID   : The ID of the event
-func (s *Session) GuildScheduledEventCreate(guildID string, event *GuildScheduledEventParams) (st *GuildScheduledEvent, err error) {
-	body, err := s.RequestWithBucketID("POST", http.EndpointGuildScheduledEvents(guildID), event, http.EndpointGuildScheduledEvents(guildID))
+func (s *Session) GuildScheduledEventCreate(guildID string, event *GuildScheduledEventParams, options ...RequestOption) (st *GuildScheduledEvent, err error) {
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildScheduledEvents(guildID), event, http.EndpointGuildScheduledEvents(guildID), options...)
 	if err != nil {
 		return
 	}
@@ -2851,8 +3367,8 @@ func (s *Session) GuildScheduledEventCreate(guildID string, event *GuildSchedule
 // GuildScheduledEventEdit updates a specific event for a guild and returns it.
 // guildID   : The ID of a Guild
 // eventID   : The ID of the event
-func (s *Session) GuildScheduledEventEdit(guildID, eventID string, event *GuildScheduledEventParams) (st *GuildScheduledEvent, err error) {
-	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildScheduledEvent(guildID, eventID), event, http.EndpointGuildScheduledEvent(guildID, eventID))
+func (s *Session) GuildScheduledEventEdit(guildID, eventID string, event *GuildScheduledEventParams, options ...RequestOption) (st *GuildScheduledEvent, err error) {
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildScheduledEvent(guildID, eventID), event, http.EndpointGuildScheduledEvent(guildID, eventID), options...)
 	if err != nil {
 		return
 	}
@@ -2864,8 +3380,8 @@ func (s *Session) GuildScheduledEventEdit(guildID, eventID string, event *GuildS
 // GuildScheduledEventDelete deletes a specific GuildScheduledEvent in a guild
 // guildID   : The ID of a Guild
 // eventID   : The ID of the event
-func (s *Session) GuildScheduledEventDelete(guildID, eventID string) (err error) {
-	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildScheduledEvent(guildID, eventID), nil, http.EndpointGuildScheduledEvent(guildID, eventID))
+func (s *Session) GuildScheduledEventDelete(guildID, eventID string, options ...RequestOption) (err error) {
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildScheduledEvent(guildID, eventID), nil, http.EndpointGuildScheduledEvent(guildID, eventID), options...)
 	return
 }
 
@@ -2876,7 +3392,7 @@ func (s *Session) GuildScheduledEventDelete(guildID, eventID string) (err error)
 // withMember : Whether to include the member object in the response
 // beforeID   : If is not empty all returned users entries will be before the given ID
 // afterID    : If is not empty all returned users entries will be after the given ID
-func (s *Session) GuildScheduledEventUsers(guildID, eventID string, limit int, withMember bool, beforeID, afterID string) (st []*GuildScheduledEventUser, err error) {
+func (s *Session) GuildScheduledEventUsers(guildID, eventID string, limit int, withMember bool, beforeID, afterID string, options ...RequestOption) (st []*GuildScheduledEventUser, err error) {
 	uri := http.EndpointGuildScheduledEventUsers(guildID, eventID)
 
 	queryParams := url.Values{}
@@ -2897,7 +3413,169 @@ func (s *Session) GuildScheduledEventUsers(guildID, eventID string, limit int, w
 		uri += "?" + queryParams.Encode()
 	}
 
-	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEventUsers(guildID, eventID))
+	body, err := s.RequestWithBucketID("GET", uri, nil, http.EndpointGuildScheduledEventUsers(guildID, eventID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to stickers
+// ------------------------------------------------------------------------------------------------
+
+// Sticker returns a sticker.
+// stickerID : The ID of a Sticker.
+func (s *Session) Sticker(stickerID string, options ...RequestOption) (st *Sticker, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointSticker(stickerID), nil, http.EndpointSticker(""), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// NitroStickerPacks returns the packs of standard stickers available to Nitro subscribers.
+func (s *Session) NitroStickerPacks(options ...RequestOption) (st []*StickerPack, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointNitroStickersPacks, nil, http.EndpointNitroStickersPacks, options...)
+	if err != nil {
+		return
+	}
+
+	var packs struct {
+		StickerPacks []*StickerPack `json:"sticker_packs"`
+	}
+	err = unmarshal(body, &packs)
+	return packs.StickerPacks, err
+}
+
+// GuildStickers returns all stickers of a guild.
+// guildID : The ID of a Guild.
+func (s *Session) GuildStickers(guildID string, options ...RequestOption) (st []*Sticker, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildStickers(guildID), nil, http.EndpointGuildStickers(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildSticker returns a sticker of a guild.
+// guildID   : The ID of a Guild.
+// stickerID : The ID of a Sticker.
+func (s *Session) GuildSticker(guildID, stickerID string, options ...RequestOption) (st *Sticker, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildSticker(guildID, stickerID), nil, http.EndpointGuildStickers(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildStickerCreate uploads a new sticker to a guild.
+// guildID : The ID of a Guild.
+// data    : The name, description and tags of the sticker.
+// file    : The PNG, APNG or Lottie JSON file of the sticker, has to be smaller than 512KB.
+func (s *Session) GuildStickerCreate(guildID string, data *StickerParams, file *File, options ...RequestOption) (st *Sticker, err error) {
+	if data == nil {
+		err = ErrStickerNoParams
+		return
+	}
+	if file == nil {
+		err = ErrStickerNoFile
+		return
+	}
+
+	fields := map[string]string{
+		"name":        data.Name,
+		"description": data.Description,
+		"tags":        data.Tags,
+	}
+
+	contentType, b, err := multipartBodyWithFields(fields, file)
+	if err != nil {
+		return
+	}
+
+	endpoint := http.EndpointGuildStickers(guildID)
+	body, err := s.request("POST", endpoint, contentType, b, endpoint, 0, options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildStickerEdit modifies a sticker of a guild.
+// guildID   : The ID of a Guild.
+// stickerID : The ID of a Sticker.
+// data      : The updated name, description and tags of the sticker.
+func (s *Session) GuildStickerEdit(guildID, stickerID string, data *StickerParams, options ...RequestOption) (st *Sticker, err error) {
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildSticker(guildID, stickerID), data, http.EndpointGuildStickers(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildStickerDelete deletes a sticker of a guild.
+// guildID   : The ID of a Guild.
+// stickerID : The ID of a Sticker.
+func (s *Session) GuildStickerDelete(guildID, stickerID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildSticker(guildID, stickerID), nil, http.EndpointGuildStickers(guildID), options...)
+	return
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to stage instances
+// ------------------------------------------------------------------------------------------------
+
+// StageInstanceCreate starts a stage instance in a stage channel.
+// data : The parameters of the stage instance, the channel ID and topic are required.
+func (s *Session) StageInstanceCreate(data *StageInstanceParams, options ...RequestOption) (st *StageInstance, err error) {
+
+	body, err := s.RequestWithBucketID("POST", http.EndpointStageInstances, data, http.EndpointStageInstances, options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// StageInstance returns the stage instance of a stage channel.
+// channelID : The ID of a stage Channel.
+func (s *Session) StageInstance(channelID string, options ...RequestOption) (st *StageInstance, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointStageInstance(channelID), nil, http.EndpointStageInstance(channelID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// StageInstanceEdit modifies the stage instance of a stage channel.
+// channelID : The ID of a stage Channel.
+// data      : The updated topic and privacy level of the stage instance.
+func (s *Session) StageInstanceEdit(channelID string, data *StageInstanceParams, options ...RequestOption) (st *StageInstance, err error) {
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointStageInstance(channelID), data, http.EndpointStageInstance(channelID), options...)
 	if err != nil {
 		return
 	}
@@ -2905,3 +3583,118 @@ func (s *Session) GuildScheduledEventUsers(guildID, eventID string, limit int, w
 	err = unmarshal(body, &st)
 	return
 }
+
+// StageInstanceDelete ends the stage instance of a stage channel.
+// channelID : The ID of a stage Channel.
+func (s *Session) StageInstanceDelete(channelID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointStageInstance(channelID), nil, http.EndpointStageInstance(channelID), options...)
+	return
+}
+
+// StageRequestToSpeak requests to speak in a stage channel, or withdraws the
+// request. The current user has to be connected to the channel.
+// guildID   : The ID of a Guild.
+// channelID : The ID of the stage Channel the current user is connected to.
+// request   : Whether to request to speak or to withdraw the request.
+func (s *Session) StageRequestToSpeak(guildID, channelID string, request bool, options ...RequestOption) (err error) {
+
+	data := struct {
+		ChannelID               string     `json:"channel_id"`
+		RequestToSpeakTimestamp *time.Time `json:"request_to_speak_timestamp"`
+	}{ChannelID: channelID}
+	if request {
+		now := time.Now().UTC()
+		data.RequestToSpeakTimestamp = &now
+	}
+
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildVoiceState(guildID, "@me"), data, http.EndpointGuildVoiceState(guildID, ""), options...)
+	return
+}
+
+// StageSpeakerSet moves a user to the speakers or the audience of a stage
+// channel. Moving another user to the speakers invites them to speak, and
+// moving the current user to the speakers makes them speak right away.
+// guildID   : The ID of a Guild.
+// channelID : The ID of the stage Channel the user is connected to.
+// userID    : The ID of a User, or "@me" for the current user.
+// speaker   : Whether the user should be a speaker or in the audience.
+func (s *Session) StageSpeakerSet(guildID, channelID, userID string, speaker bool, options ...RequestOption) (err error) {
+
+	data := struct {
+		ChannelID string `json:"channel_id"`
+		Suppress  bool   `json:"suppress"`
+	}{channelID, !speaker}
+
+	_, err = s.RequestWithBucketID("PATCH", http.EndpointGuildVoiceState(guildID, userID), data, http.EndpointGuildVoiceState(guildID, ""), options...)
+	return
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to auto moderation
+// ------------------------------------------------------------------------------------------------
+
+// AutoModerationRules returns all auto moderation rules of a guild.
+// guildID : The ID of a Guild.
+func (s *Session) AutoModerationRules(guildID string, options ...RequestOption) (st []*AutoModerationRule, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildAutoModerationRules(guildID), nil, http.EndpointGuildAutoModerationRules(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// AutoModerationRule returns an auto moderation rule of a guild.
+// guildID : The ID of a Guild.
+// ruleID  : The ID of an auto moderation rule.
+func (s *Session) AutoModerationRule(guildID, ruleID string, options ...RequestOption) (st *AutoModerationRule, err error) {
+
+	body, err := s.RequestWithBucketID("GET", http.EndpointGuildAutoModerationRule(guildID, ruleID), nil, http.EndpointGuildAutoModerationRules(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// AutoModerationRuleCreate creates an auto moderation rule in a guild.
+// guildID : The ID of a Guild.
+// rule    : The parameters of the rule, the name, event type, trigger type and actions are required.
+func (s *Session) AutoModerationRuleCreate(guildID string, rule *AutoModerationRuleParams, options ...RequestOption) (st *AutoModerationRule, err error) {
+
+	body, err := s.RequestWithBucketID("POST", http.EndpointGuildAutoModerationRules(guildID), rule, http.EndpointGuildAutoModerationRules(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// AutoModerationRuleEdit modifies an auto moderation rule of a guild.
+// guildID : The ID of a Guild.
+// ruleID  : The ID of an auto moderation rule.
+// rule    : The updated parameters of the rule.
+func (s *Session) AutoModerationRuleEdit(guildID, ruleID string, rule *AutoModerationRuleParams, options ...RequestOption) (st *AutoModerationRule, err error) {
+
+	body, err := s.RequestWithBucketID("PATCH", http.EndpointGuildAutoModerationRule(guildID, ruleID), rule, http.EndpointGuildAutoModerationRules(guildID), options...)
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// AutoModerationRuleDelete deletes an auto moderation rule of a guild.
+// guildID : The ID of a Guild.
+// ruleID  : The ID of an auto moderation rule.
+func (s *Session) AutoModerationRuleDelete(guildID, ruleID string, options ...RequestOption) (err error) {
+
+	_, err = s.RequestWithBucketID("DELETE", http.EndpointGuildAutoModerationRule(guildID, ruleID), nil, http.EndpointGuildAutoModerationRules(guildID), options...)
+	return
+}
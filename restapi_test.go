@@ -1,8 +1,15 @@
 package astatine
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	netHttp "net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/ayntgl/astatine/http"
 )
 
 //////////////////////////////////////////////////////////////////////////////
@@ -235,3 +242,258 @@ func Test_unmarshal(t *testing.T) {
 		t.Errorf("Unexpected error type: %T", err)
 	}
 }
+
+// newTestSession returns a session which sends its REST requests to a test
+// server serving handler, without ratelimiting them, and a function closing
+// the server.
+func newTestSession(handler netHttp.HandlerFunc) (*Session, func()) {
+	srv := httptest.NewServer(handler)
+
+	s := New("")
+	s.APIBase = srv.URL + "/"
+	s.Ratelimiter = http.NopLimiter
+	return s, srv.Close
+}
+
+func TestSessionAPIBase(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path != "/api/users/@me" {
+			t.Errorf("request path is %q, want %q", r.URL.Path, "/api/users/@me")
+		}
+		w.Write([]byte(`{"id":"1","username":"test"}`))
+	})
+	defer closeServer()
+	s.APIBase += "api/"
+
+	u, err := s.User("@me")
+	if err != nil {
+		t.Fatalf("User returned error: %v", err)
+	}
+	if u.ID != "1" {
+		t.Errorf("user ID is %q, want %q", u.ID, "1")
+	}
+}
+
+func TestRESTError(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		switch r.URL.Path {
+		case "/channels/1/messages/2":
+			w.WriteHeader(netHttp.StatusNotFound)
+			w.Write([]byte(`{"message": "Unknown Message", "code": 10008}`))
+		default:
+			w.WriteHeader(netHttp.StatusBadRequest)
+			w.Write([]byte(`{"code": 50035, "message": "Invalid Form Body", "errors": {
+				"content": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "Must be 2000 or fewer in length."}]},
+				"embeds": {"0": {"title": {"_errors": [{"code": "BASE_TYPE_REQUIRED", "message": "This field is required"}]}}}
+			}}`))
+		}
+	})
+	defer closeServer()
+
+	_, err := s.ChannelMessage("1", "2")
+	if !errors.Is(err, ErrUnknownMessage) {
+		t.Errorf("ChannelMessage returned %v, want %v", err, ErrUnknownMessage)
+	}
+	var code ErrorCode
+	if !errors.As(err, &code) || code != ErrCodeUnknownMessage {
+		t.Errorf("error code is %d, want %d", code, ErrCodeUnknownMessage)
+	}
+
+	_, err = s.ChannelMessageSend("1", "content")
+	var restErr *RESTError
+	if !errors.As(err, &restErr) || !errors.Is(err, ErrInvalidFormBody) {
+		t.Fatalf("ChannelMessageSend returned %v, want %v", err, ErrInvalidFormBody)
+	}
+	for path, code := range map[string]string{
+		"content":        "BASE_TYPE_MAX_LENGTH",
+		"embeds.0.title": "BASE_TYPE_REQUIRED",
+	} {
+		if errs := restErr.Message.Errors[path]; len(errs) != 1 || errs[0].Code != code {
+			t.Errorf("errors of %s are %+v, want %s", path, errs, code)
+		}
+	}
+}
+
+func TestGuildStickerCreate(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path != "/guilds/1/stickers" {
+			t.Errorf("path is %q, want %q", r.URL.Path, "/guilds/1/stickers")
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm returned error: %v", err)
+			return
+		}
+		for field, want := range map[string]string{"name": "name", "description": "description", "tags": "smile"} {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("%s is %q, want %q", field, got, want)
+			}
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile returned error: %v", err)
+			return
+		}
+		defer file.Close()
+		if header.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("file content type is %q, want %q", header.Header.Get("Content-Type"), "image/png")
+		}
+		w.Write([]byte(`{"id": "2", "name": "name", "guild_id": "1"}`))
+	})
+	defer closeServer()
+
+	st, err := s.GuildStickerCreate("1", &StickerParams{Name: "name", Description: "description", Tags: "smile"}, &File{
+		Name:        "sticker.png",
+		ContentType: "image/png",
+		Reader:      strings.NewReader("png"),
+	})
+	if err != nil {
+		t.Fatalf("GuildStickerCreate returned error: %v", err)
+	}
+	if st.ID != "2" || st.GuildID != "1" {
+		t.Errorf("unexpected sticker %+v", st)
+	}
+	if _, err = s.GuildStickerCreate("1", &StickerParams{Name: "name"}, nil); err != ErrStickerNoFile {
+		t.Errorf("GuildStickerCreate returned %v without a file, want %v", err, ErrStickerNoFile)
+	}
+	if _, err = s.GuildStickerCreate("1", nil, &File{Name: "sticker.png"}); err != ErrStickerNoParams {
+		t.Errorf("GuildStickerCreate returned %v without params, want %v", err, ErrStickerNoParams)
+	}
+}
+
+func TestStageRequestToSpeak(t *testing.T) {
+	var (
+		path string
+		data map[string]interface{}
+	)
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		path = r.URL.Path
+		data = nil
+		json.NewDecoder(r.Body).Decode(&data)
+		w.WriteHeader(netHttp.StatusNoContent)
+	})
+	defer closeServer()
+
+	if err := s.StageRequestToSpeak("1", "2", true); err != nil {
+		t.Fatalf("StageRequestToSpeak returned error: %v", err)
+	}
+	if path != "/guilds/1/voice-states/@me" || data["channel_id"] != "2" || data["request_to_speak_timestamp"] == nil {
+		t.Errorf("unexpected request to %s: %v", path, data)
+	}
+
+	if err := s.StageRequestToSpeak("1", "2", false); err != nil {
+		t.Fatalf("StageRequestToSpeak returned error: %v", err)
+	}
+	if v, ok := data["request_to_speak_timestamp"]; !ok || v != nil {
+		t.Errorf("request to speak was not withdrawn: %v", data)
+	}
+
+	if err := s.StageSpeakerSet("1", "2", "3", true); err != nil {
+		t.Fatalf("StageSpeakerSet returned error: %v", err)
+	}
+	if path != "/guilds/1/voice-states/3" || data["suppress"] != false {
+		t.Errorf("unexpected request to %s: %v", path, data)
+	}
+}
+
+func TestAutoModerationRuleEdit(t *testing.T) {
+	var data map[string]json.RawMessage
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/guilds/1/auto-moderation/rules/2" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&data)
+		w.Write([]byte(`{"id": "2", "guild_id": "1", "trigger_type": 1, "trigger_metadata": {"keyword_filter": ["word"]}, "actions": [{"type": 3, "metadata": {"duration_seconds": 60}}]}`))
+	})
+	defer closeServer()
+
+	enabled := false
+	exemptRoles := []string{}
+	rule, err := s.AutoModerationRuleEdit("1", "2", &AutoModerationRuleParams{
+		TriggerMetadata: &AutoModerationTriggerMetadata{KeywordFilter: []string{"word"}},
+		Enabled:         &enabled,
+		ExemptRoles:     &exemptRoles,
+	})
+	if err != nil {
+		t.Fatalf("AutoModerationRuleEdit returned error: %v", err)
+	}
+
+	for field, want := range map[string]string{
+		"enabled":          `false`,
+		"exempt_roles":     `[]`,
+		"trigger_metadata": `{"keyword_filter":["word"]}`,
+	} {
+		if string(data[field]) != want {
+			t.Errorf("%s is %s, want %s", field, data[field], want)
+		}
+	}
+	if _, ok := data["name"]; ok {
+		t.Error("unset name was sent")
+	}
+
+	if rule.TriggerType != AutoModerationTriggerKeyword || len(rule.Actions) != 1 || rule.Actions[0].Metadata.Duration != 60 {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+}
+
+func TestForumThreadStartComplex(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path != "/channels/1/threads" {
+			t.Errorf("path is %q, want %q", r.URL.Path, "/channels/1/threads")
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm returned error: %v", err)
+			return
+		}
+
+		var data struct {
+			Name        string   `json:"name"`
+			AppliedTags []string `json:"applied_tags"`
+			Message     struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(r.FormValue("payload_json")), &data); err != nil {
+			t.Errorf("payload_json is invalid: %v", err)
+		}
+		if data.Name != "post" || len(data.AppliedTags) != 1 || data.Message.Content != "content" {
+			t.Errorf("unexpected payload %+v", data)
+		}
+		if _, _, err := r.FormFile("file0"); err != nil {
+			t.Errorf("FormFile returned error: %v", err)
+		}
+		w.Write([]byte(`{"id": "2", "type": 11, "parent_id": "1", "applied_tags": ["3"]}`))
+	})
+	defer closeServer()
+
+	th, err := s.ForumThreadStartComplex("1", &ThreadStart{Name: "post", AppliedTags: []string{"3"}}, &MessageSend{
+		Content: "content",
+		Files:   []*File{{Name: "log.txt", Reader: strings.NewReader("log")}},
+	})
+	if err != nil {
+		t.Fatalf("ForumThreadStartComplex returned error: %v", err)
+	}
+	if th.ID != "2" || !th.IsThread() || len(th.AppliedTags) != 1 {
+		t.Errorf("unexpected thread %+v", th)
+	}
+
+	if _, err = s.ForumThreadStartComplex("1", &ThreadStart{Name: "post"}, nil); err != ErrForumPostNoMessage {
+		t.Errorf("ForumThreadStartComplex returned %v without a message, want %v", err, ErrForumPostNoMessage)
+	}
+}
+
+func TestThreadTagsEdit(t *testing.T) {
+	var body string
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`{"id": "1"}`))
+	})
+	defer closeServer()
+
+	if _, err := s.ThreadTagsEdit("1", nil); err != nil {
+		t.Fatalf("ThreadTagsEdit returned error: %v", err)
+	}
+	if body != `{"applied_tags":[]}` {
+		t.Errorf("body is %s, want %s", body, `{"applied_tags":[]}`)
+	}
+}
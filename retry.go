@@ -0,0 +1,111 @@
+// This file contains the retry policy of REST requests, which decides when a
+// request that failed with a transient error is sent again.
+
+package astatine
+
+import (
+	"math/rand"
+	netHttp "net/http"
+	"time"
+)
+
+// A RetryPolicy decides which failed REST requests are sent again, and how
+// long to wait before each retry. A request is sent at most
+// Session.MaxRestRetries more times. Rate limited requests are always sent
+// again once the rate limit is over, regardless of the policy.
+type RetryPolicy struct {
+	// The HTTP status codes of responses which are retried.
+	StatusCodes []int
+
+	// Whether requests which failed without a response, e.g. because the
+	// connection was reset or timed out, are retried.
+	NetworkErrors bool
+
+	// Whether requests with a method which is not idempotent, POST and
+	// PATCH, are retried. Discord may have handled such a request before it
+	// failed, so retrying it can e.g. send a message twice.
+	RetryNonIdempotent bool
+
+	// The wait before the first retry, which is doubled for every further
+	// retry up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// The fraction of the wait which is added at random, so that many
+	// failed requests are not retried at once.
+	Jitter float64
+
+	// The longest time since the first attempt of a request after which
+	// it is still retried, no limit when zero.
+	MaxElapsed time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of sessions created with New.
+var DefaultRetryPolicy = RetryPolicy{
+	StatusCodes: []int{
+		netHttp.StatusInternalServerError,
+		netHttp.StatusBadGateway,
+		netHttp.StatusServiceUnavailable,
+		netHttp.StatusGatewayTimeout,
+	},
+	NetworkErrors: true,
+	MinBackoff:    500 * time.Millisecond,
+	MaxBackoff:    10 * time.Second,
+	Jitter:        0.2,
+	MaxElapsed:    time.Minute,
+}
+
+// isZero returns whether the policy is the zero value.
+func (p *RetryPolicy) isZero() bool {
+	return p.StatusCodes == nil && !p.NetworkErrors && !p.RetryNonIdempotent &&
+		p.MinBackoff == 0 && p.MaxBackoff == 0 && p.Jitter == 0 && p.MaxElapsed == 0
+}
+
+// clone returns a copy of the policy which doesn't share its StatusCodes.
+func (p RetryPolicy) clone() RetryPolicy {
+	if p.StatusCodes != nil {
+		p.StatusCodes = append([]int{}, p.StatusCodes...)
+	}
+	return p
+}
+
+// retryable returns whether a request which failed with statusCode, or 0 if
+// it failed without a response, may be retried.
+func (p *RetryPolicy) retryable(method string, statusCode int) bool {
+	switch method {
+	case "POST", "PATCH":
+		if !p.RetryNonIdempotent {
+			return false
+		}
+	}
+
+	if statusCode == 0 {
+		return p.NetworkErrors
+	}
+
+	for _, code := range p.StatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait before the retry with the given number, starting
+// at 0.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		d += time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+
+	return d
+}
@@ -0,0 +1,111 @@
+package astatine
+
+import (
+	netHttp "net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for retry, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if d := p.backoff(retry); d != want {
+			t.Errorf("backoff(%d) is %v, want %v", retry, d, want)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.backoff(0); d < time.Second || d > 1500*time.Millisecond {
+			t.Fatalf("backoff(0) with jitter is %v", d)
+		}
+	}
+}
+
+func TestRequestRetry(t *testing.T) {
+	var requests int32
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			// Fail without a response.
+			conn, _, _ := w.(netHttp.Hijacker).Hijack()
+			conn.Close()
+		case 2:
+			w.WriteHeader(netHttp.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"id": "1"}`))
+		}
+	})
+	defer closeServer()
+	s.RetryPolicy.MinBackoff = time.Millisecond
+	s.RetryPolicy.Jitter = 0
+
+	var retries []*RequestRetry
+	s.SyncEvents = true
+	s.AddHandler(func(_ *Session, r *RequestRetry) {
+		retries = append(retries, r)
+	})
+
+	u, err := s.User("1")
+	if err != nil {
+		t.Fatalf("User returned error: %v", err)
+	}
+	if u.ID != "1" {
+		t.Errorf("user ID is %q, want %q", u.ID, "1")
+	}
+	if len(retries) != 2 {
+		t.Fatalf("%d retries, want 2", len(retries))
+	}
+	if retries[0].StatusCode != 0 || retries[0].Err == nil {
+		t.Errorf("first retry is %+v, want a network error", retries[0])
+	}
+	if retries[1].StatusCode != netHttp.StatusServiceUnavailable || retries[1].Attempt != 2 || retries[1].Delay != 2*time.Millisecond {
+		t.Errorf("second retry is %+v", retries[1])
+	}
+
+	// Requests which are not idempotent are only retried when allowed.
+	atomic.StoreInt32(&requests, 1)
+	if _, err = s.ChannelMessageSend("1", "content"); err == nil {
+		t.Error("ChannelMessageSend did not return the error of the failed request")
+	}
+
+	atomic.StoreInt32(&requests, 1)
+	policy := s.RetryPolicy
+	policy.RetryNonIdempotent = true
+	if _, err = s.ChannelMessageSend("1", "content", WithRetryPolicy(policy)); err != nil {
+		t.Errorf("ChannelMessageSend returned error: %v", err)
+	}
+
+	// Requests are retried at most MaxRestRetries times.
+	atomic.StoreInt32(&requests, 1)
+	s.MaxRestRetries = 0
+	if _, err = s.User("1"); !errorIsStatus(err, netHttp.StatusServiceUnavailable) {
+		t.Errorf("User returned %v, want the error of the failed request", err)
+	}
+}
+
+// errorIsStatus returns whether err is a RESTError of a response with the
+// status code.
+func errorIsStatus(err error, statusCode int) bool {
+	restErr, ok := err.(*RESTError)
+	return ok && restErr.Response.StatusCode == statusCode
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	// Sessions which were not created with New use DefaultRetryPolicy.
+	s := &Session{MaxRestRetries: 3}
+	cfg := newRequestConfig([]RequestOption{withStart(time.Now())})
+	if _, ok := s.retryDelay(cfg, "GET", 0, netHttp.StatusServiceUnavailable); !ok {
+		t.Error("request is not retried with the zero retry policy")
+	}
+
+	codes := []int{netHttp.StatusServiceUnavailable}
+	opt := WithRetryPolicy(RetryPolicy{StatusCodes: codes})
+	codes[0] = netHttp.StatusInternalServerError
+
+	cfg = newRequestConfig([]RequestOption{opt})
+	if cfg.RetryPolicy.StatusCodes[0] != netHttp.StatusServiceUnavailable {
+		t.Errorf("retry policy status code is %d after changing the caller's slice", cfg.RetryPolicy.StatusCodes[0])
+	}
+}
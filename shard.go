@@ -0,0 +1,462 @@
+// This file contains the ShardManager, which runs many gateway sessions as a
+// single logical client.
+
+package astatine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ayntgl/astatine/http"
+)
+
+// ErrShardManagerOpen is returned when opening a ShardManager which is
+// already open.
+var ErrShardManagerOpen = errors.New("shard manager already opened")
+
+// ErrShardManagerClosed is returned when restarting a ShardManager which
+// isn't open.
+var ErrShardManagerClosed = errors.New("shard manager not opened")
+
+// shardIdentifyInterval is the time Discord requires between identifies that
+// share a max_concurrency bucket.
+const shardIdentifyInterval = 5 * time.Second
+
+// A ShardManager runs one Session per shard, sharing a single State and
+// RateLimiter between them, so that they can be used as one client.
+type ShardManager struct {
+	sync.RWMutex
+
+	// The token used by every shard.
+	Token string
+
+	// The number of shards to run. When zero, the shard count recommended
+	// by Discord is used.
+	ShardCount int
+
+	// The State and Limiter shared by all shards.
+	State       *State
+	Ratelimiter http.Limiter
+
+	// Configure, if set, is called for every shard session before it is
+	// opened, and can be used to set intents, logging, etc.
+	Configure func(s *Session)
+
+	// The sessions of the running shards, indexed by shard ID.
+	// Exposed but should not be modified by User.
+	Shards []*Session
+
+	// lifecycle serializes Open, Close, Restart and Reshard.
+	lifecycle sync.Mutex
+
+	gateway        string
+	maxConcurrency int
+	handlers       []*shardHandler
+}
+
+// shardHandler is a handler added to every shard of a ShardManager.
+type shardHandler struct {
+	handler  interface{}
+	removers map[*Session]func()
+}
+
+// NewShardManager creates a new ShardManager for the provided token.
+// If the token is for a bot, it must be prefixed with "Bot "
+func NewShardManager(token string) *ShardManager {
+	return &ShardManager{
+		Token:       token,
+		State:       NewState(),
+		Ratelimiter: http.NewRatelimiter(),
+	}
+}
+
+// GuildShardID returns the ID of the shard which receives the events of a
+// guild, for the given shard count.
+func GuildShardID(guildID string, shardCount int) int {
+	if shardCount < 2 {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return int((id >> 22) % uint64(shardCount))
+}
+
+// newShard creates the Session for a shard.
+func (m *ShardManager) newShard(shardID, shardCount int) *Session {
+	s := New(m.Token)
+	if m.Configure != nil {
+		m.Configure(s)
+	}
+
+	s.ShardID = shardID
+	s.ShardCount = shardCount
+	s.State = m.State
+	s.Ratelimiter = m.Ratelimiter
+	s.gateway = m.gateway
+
+	return s
+}
+
+// Open fetches the recommended shard count and gateway limits, then opens
+// every shard, identifying as fast as max_concurrency allows.
+func (m *ShardManager) Open(options ...RequestOption) error {
+	m.lifecycle.Lock()
+	defer m.lifecycle.Unlock()
+
+	m.RLock()
+	open := len(m.Shards) > 0
+	m.RUnlock()
+	if open {
+		return ErrShardManagerOpen
+	}
+
+	count, err := m.gatewayBot(m.ShardCount, options...)
+	if err != nil {
+		return err
+	}
+
+	shards := m.newShards(count)
+
+	// Handlers are added before opening, so that the READY events of the
+	// first connection reach them.
+	m.Lock()
+	m.addHandlers(shards)
+	m.Shards = shards
+	m.Unlock()
+
+	if err = m.openShards(shards); err != nil {
+		m.Lock()
+		m.removeHandlers(shards)
+		m.Shards = nil
+		m.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Close closes every shard.
+func (m *ShardManager) Close() error {
+	m.lifecycle.Lock()
+	defer m.lifecycle.Unlock()
+
+	m.Lock()
+	shards := m.Shards
+	m.Shards = nil
+	for _, h := range m.handlers {
+		h.removeAll()
+	}
+	m.Unlock()
+
+	var err error
+	for _, s := range shards {
+		if err2 := s.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+	}
+
+	return err
+}
+
+// Restart restarts every shard, one at a time. Each shard is replaced by a
+// new session once that session is ready, so the other shards keep
+// receiving events during the restart.
+func (m *ShardManager) Restart() error {
+	m.lifecycle.Lock()
+	defer m.lifecycle.Unlock()
+
+	m.RLock()
+	count := len(m.Shards)
+	m.RUnlock()
+	if count == 0 {
+		return ErrShardManagerClosed
+	}
+
+	for id := 0; id < count; id++ {
+		if id > 0 {
+			time.Sleep(shardIdentifyInterval)
+		}
+
+		// As in Open, handlers are added before opening the new session.
+		s := m.newShard(id, count)
+		m.Lock()
+		m.addHandlers([]*Session{s})
+		m.Unlock()
+
+		if err := s.Open(); err != nil {
+			m.Lock()
+			m.removeHandlers([]*Session{s})
+			m.Unlock()
+			return fmt.Errorf("error restarting shard %d, %w", id, err)
+		}
+
+		m.Lock()
+		old := m.Shards[id]
+		m.Shards[id] = s
+		m.removeHandlers([]*Session{old})
+		m.Unlock()
+
+		old.Close()
+	}
+
+	return nil
+}
+
+// Reshard replaces every shard with a new set of shardCount shards. When
+// shardCount is zero, the shard count recommended by Discord is used.
+// The current shards keep running until all of the new shards are ready.
+func (m *ShardManager) Reshard(shardCount int, options ...RequestOption) error {
+	m.lifecycle.Lock()
+	defer m.lifecycle.Unlock()
+
+	m.RLock()
+	open := len(m.Shards) > 0
+	m.RUnlock()
+	if !open {
+		return ErrShardManagerClosed
+	}
+
+	count, err := m.gatewayBot(shardCount, options...)
+	if err != nil {
+		return err
+	}
+
+	// As in Open, handlers are added before opening the new shards.
+	shards := m.newShards(count)
+	m.Lock()
+	m.addHandlers(shards)
+	m.Unlock()
+
+	if err = m.openShards(shards); err != nil {
+		m.Lock()
+		m.removeHandlers(shards)
+		m.Unlock()
+		return err
+	}
+
+	m.Lock()
+	old := m.Shards
+	m.Shards = shards
+	m.ShardCount = shardCount
+	m.removeHandlers(old)
+	m.Unlock()
+
+	for _, s := range old {
+		s.Close()
+	}
+
+	return nil
+}
+
+// gatewayBot fetches the gateway and max_concurrency of the bot, returning
+// shardCount, or the recommended shard count if shardCount is zero.
+func (m *ShardManager) gatewayBot(shardCount int, options ...RequestOption) (int, error) {
+	gb, err := m.newShard(0, 1).GatewayBot(options...)
+	if err != nil {
+		return 0, err
+	}
+
+	m.gateway = gb.URL
+	m.maxConcurrency = gb.SessionStartLimit.MaxConcurrency
+	if m.maxConcurrency < 1 {
+		m.maxConcurrency = 1
+	}
+
+	if shardCount < 1 {
+		shardCount = gb.Shards
+	}
+	if shardCount < 1 {
+		shardCount = 1
+	}
+
+	return shardCount, nil
+}
+
+func (m *ShardManager) newShards(count int) []*Session {
+	shards := make([]*Session, count)
+	for id := range shards {
+		shards[id] = m.newShard(id, count)
+	}
+	return shards
+}
+
+// openShards opens shards, max_concurrency shards at a time. Shard IDs in a
+// group all fall into different identify buckets. If a shard fails to open,
+// the shards already opened are closed.
+func (m *ShardManager) openShards(shards []*Session) error {
+	for i := 0; i < len(shards); i += m.maxConcurrency {
+		if i > 0 {
+			time.Sleep(shardIdentifyInterval)
+		}
+
+		end := i + m.maxConcurrency
+		if end > len(shards) {
+			end = len(shards)
+		}
+
+		var wg sync.WaitGroup
+		errs := make([]error, end-i)
+		for j, s := range shards[i:end] {
+			wg.Add(1)
+			go func(j int, s *Session) {
+				defer wg.Done()
+				errs[j] = s.Open()
+			}(j, s)
+		}
+		wg.Wait()
+
+		for j, err := range errs {
+			if err != nil {
+				for _, s := range shards[:end] {
+					s.Close()
+				}
+				return fmt.Errorf("error opening shard %d, %w", i+j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addHandlers adds every handler to the given sessions.
+// The caller must hold the lock.
+func (m *ShardManager) addHandlers(shards []*Session) {
+	for _, h := range m.handlers {
+		for _, s := range shards {
+			h.add(s)
+		}
+	}
+}
+
+// removeHandlers removes every handler from the given sessions.
+// The caller must hold the lock.
+func (m *ShardManager) removeHandlers(shards []*Session) {
+	for _, h := range m.handlers {
+		for _, s := range shards {
+			h.remove(s)
+		}
+	}
+}
+
+// AddHandler adds an event handler to every shard, including the shards
+// started later by Restart or Reshard. See Session.AddHandler.
+// The returned function removes the handler from every shard.
+func (m *ShardManager) AddHandler(handler interface{}) func() {
+	if handlerForInterface(handler) == nil {
+		return func() {}
+	}
+
+	h := &shardHandler{
+		handler:  handler,
+		removers: make(map[*Session]func()),
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, s := range m.Shards {
+		h.add(s)
+	}
+	m.handlers = append(m.handlers, h)
+
+	return func() {
+		m.Lock()
+		defer m.Unlock()
+
+		for i, v := range m.handlers {
+			if v == h {
+				m.handlers = append(m.handlers[:i], m.handlers[i+1:]...)
+				break
+			}
+		}
+		h.removeAll()
+	}
+}
+
+func (h *shardHandler) add(s *Session) {
+	h.removers[s] = s.AddHandler(h.handler)
+}
+
+func (h *shardHandler) remove(s *Session) {
+	if r, ok := h.removers[s]; ok {
+		r()
+		delete(h.removers, s)
+	}
+}
+
+func (h *shardHandler) removeAll() {
+	for s, r := range h.removers {
+		r()
+		delete(h.removers, s)
+	}
+}
+
+// Shard returns the session of a shard, or nil if there is no such shard.
+func (m *ShardManager) Shard(shardID int) *Session {
+	m.RLock()
+	defer m.RUnlock()
+
+	if shardID < 0 || shardID >= len(m.Shards) {
+		return nil
+	}
+	return m.Shards[shardID]
+}
+
+// GuildShard returns the session of the shard which receives the events of
+// a guild. Guild scoped REST calls and voice connections should be made
+// through it.
+func (m *ShardManager) GuildShard(guildID string) *Session {
+	m.RLock()
+	defer m.RUnlock()
+
+	if len(m.Shards) == 0 {
+		return nil
+	}
+	return m.Shards[GuildShardID(guildID, len(m.Shards))]
+}
+
+// ChannelVoiceJoin joins a voice channel through the shard of its guild.
+// See Session.ChannelVoiceJoin.
+func (m *ShardManager) ChannelVoiceJoin(gID, cID string, mute, deaf bool) (*VoiceConnection, error) {
+	s := m.GuildShard(gID)
+	if s == nil {
+		return nil, ErrWSNotFound
+	}
+	return s.ChannelVoiceJoin(gID, cID, mute, deaf)
+}
+
+// RequestGuildMembers requests guild members through the shard of the
+// guild. See Session.RequestGuildMembers.
+func (m *ShardManager) RequestGuildMembers(guildID string, query string, limit int, presences bool) error {
+	s := m.GuildShard(guildID)
+	if s == nil {
+		return ErrWSNotFound
+	}
+	return s.RequestGuildMembers(guildID, query, limit, presences)
+}
+
+// UpdateStatusComplex updates the status on every shard.
+// See Session.UpdateStatusComplex.
+func (m *ShardManager) UpdateStatusComplex(usd UpdateStatusData) error {
+	m.RLock()
+	shards := append([]*Session(nil), m.Shards...)
+	m.RUnlock()
+
+	if len(shards) == 0 {
+		return ErrWSNotFound
+	}
+
+	for _, s := range shards {
+		if err := s.UpdateStatusComplex(usd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package astatine
+
+import (
+	netHttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestGuildShardID(t *testing.T) {
+	tests := []struct {
+		guildID    string
+		shardCount int
+		want       int
+	}{
+		{"81384788765712384", 1, 0},
+		{"81384788765712384", 16, 2},
+		// 5 << 22
+		{"20971520", 4, 1},
+		{"invalid", 4, 0},
+	}
+
+	for _, tt := range tests {
+		if got := GuildShardID(tt.guildID, tt.shardCount); got != tt.want {
+			t.Errorf("GuildShardID(%q, %d) = %d, want %d", tt.guildID, tt.shardCount, got, tt.want)
+		}
+	}
+}
+
+func TestShardManagerSharedStateReady(t *testing.T) {
+	m := NewShardManager("")
+	shards := m.newShards(2)
+
+	// The guild with ID (n << 22) belongs to shard n % 2.
+	guilds := []*Guild{
+		{ID: strconv.FormatUint(2<<22, 10)},
+		{ID: strconv.FormatUint(3<<22, 10)},
+	}
+
+	for id, s := range shards {
+		if err := m.State.onReady(s, &Ready{Guilds: []*Guild{guilds[id]}}); err != nil {
+			t.Fatalf("onReady returned error: %v", err)
+		}
+	}
+
+	if len(m.State.Guilds) != 2 {
+		t.Fatalf("State has %d guilds, want 2", len(m.State.Guilds))
+	}
+	for _, g := range guilds {
+		if _, err := m.State.Guild(g.ID); err != nil {
+			t.Errorf("State.Guild(%q) returned error: %v", g.ID, err)
+		}
+	}
+}
+
+func TestShardManagerRestartReady(t *testing.T) {
+	srv := httptest.NewServer(netHttp.HandlerFunc(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade returned error: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(map[string]interface{}{"op": 10, "d": map[string]interface{}{"heartbeat_interval": 45000}})
+
+		var identify map[string]interface{}
+		if err = conn.ReadJSON(&identify); err != nil {
+			return
+		}
+		conn.WriteJSON(map[string]interface{}{"op": 0, "s": 1, "t": "READY", "d": map[string]interface{}{"session_id": "session"}})
+
+		for {
+			if _, _, err = conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	m := NewShardManager("")
+	m.gateway = "ws" + strings.TrimPrefix(srv.URL, "http") + "/"
+	m.maxConcurrency = 1
+	m.Shards = m.newShards(1)
+	defer m.Close()
+
+	ready := make(chan struct{}, 1)
+	m.AddHandler(func(s *Session, r *Ready) {
+		ready <- struct{}{}
+	})
+
+	if err := m.Restart(); err != nil {
+		t.Fatalf("Restart returned error: %v", err)
+	}
+
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Ready handler was not called during Restart")
+	}
+}
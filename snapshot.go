@@ -0,0 +1,99 @@
+// This file contains functions to save a session and its state, and to restore
+// them in a new process, so that the gateway session can be resumed.
+
+package astatine
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+)
+
+// ErrSnapshotShard is returned when restoring a snapshot taken by another
+// shard.
+var ErrSnapshotShard = errors.New("snapshot was taken by a different shard")
+
+// A SessionSnapshot holds what is needed to resume a gateway session in a
+// new Session, along with the State of the old one. It can be stored as JSON.
+type SessionSnapshot struct {
+	SessionID        string `json:"session_id"`
+	Sequence         int64  `json:"sequence"`
+	ResumeGatewayURL string `json:"resume_gateway_url"`
+	ShardID          int    `json:"shard_id"`
+	ShardCount       int    `json:"shard_count"`
+
+	// State holds the Ready of the State as JSON, including the guilds
+	// and private channels of its StateStore. Cached messages are not
+	// included.
+	State json.RawMessage `json:"state,omitempty"`
+}
+
+// Snapshot returns a snapshot of the gateway session and of the State.
+// It should be taken after closing the session with
+// CloseWithCode(websocket.CloseServiceRestart): Close ends the gateway
+// session, which can then no longer be resumed.
+func (s *Session) Snapshot() (*SessionSnapshot, error) {
+	s.RLock()
+	snap := &SessionSnapshot{
+		SessionID:        s.sessionID,
+		Sequence:         atomic.LoadInt64(s.sequence),
+		ResumeGatewayURL: s.resumeGateway,
+		ShardID:          s.ShardID,
+		ShardCount:       s.ShardCount,
+	}
+	s.RUnlock()
+
+	if s.State == nil {
+		return snap, nil
+	}
+
+	s.State.RLock()
+	defer s.State.RUnlock()
+
+	r := s.State.Ready
+
+	var err error
+	if r.Guilds, err = s.State.Store.Guilds(); err != nil {
+		return nil, err
+	}
+	if r.PrivateChannels, err = s.State.Store.PrivateChannels(); err != nil {
+		return nil, err
+	}
+
+	snap.State, err = json.Marshal(r)
+	return snap, err
+}
+
+// Restore loads a snapshot into a Session which is not open, so that the next
+// call to Open resumes the snapshotted gateway session instead of identifying
+// a new one. If Discord no longer allows the session to be resumed, Open
+// identifies as usual.
+func (s *Session) Restore(snap *SessionSnapshot) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.wsConn != nil {
+		return ErrWSAlreadyOpen
+	}
+
+	if snap.ShardID != s.ShardID || snap.ShardCount != s.ShardCount {
+		return ErrSnapshotShard
+	}
+
+	if len(snap.State) != 0 && s.State != nil {
+		var r Ready
+		if err := json.Unmarshal(snap.State, &r); err != nil {
+			return err
+		}
+
+		if err := s.State.onReady(s, &r); err != nil {
+			return err
+		}
+	}
+
+	s.sessionID = snap.SessionID
+	s.resumeGateway = snap.ResumeGatewayURL
+	atomic.StoreInt64(s.sequence, snap.Sequence)
+
+	return nil
+}
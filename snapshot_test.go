@@ -0,0 +1,61 @@
+package astatine
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSessionSnapshotRestore(t *testing.T) {
+	s := New("")
+	s.sessionID = "session"
+	s.resumeGateway = "wss://resume.discord.gg"
+	atomic.StoreInt64(s.sequence, 42)
+	s.State.User = &User{ID: "me"}
+	s.State.GuildAdd(&Guild{
+		ID:       "guild",
+		Channels: []*Channel{{ID: "channel", GuildID: "guild"}},
+		Members:  []*Member{{GuildID: "guild", User: &User{ID: "me"}}},
+	})
+
+	snap, err := s.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("error marshalling snapshot: %v", err)
+	}
+	var restored SessionSnapshot
+	if err = json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("error unmarshalling snapshot: %v", err)
+	}
+
+	n := New("")
+	if err = n.Restore(&restored); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	if n.sessionID != "session" || atomic.LoadInt64(n.sequence) != 42 {
+		t.Errorf("Restore set session %q, sequence %d", n.sessionID, atomic.LoadInt64(n.sequence))
+	}
+	if got := n.gatewayURL(true); got[:len("wss://resume.discord.gg/?")] != "wss://resume.discord.gg/?" {
+		t.Errorf("gatewayURL returned %q, want the resume gateway", got)
+	}
+	if n.State.User == nil || n.State.User.ID != "me" {
+		t.Errorf("Restore did not restore the current user")
+	}
+	if _, err = n.State.Channel("channel"); err != nil {
+		t.Errorf("State.Channel returned error: %v", err)
+	}
+	if _, err = n.State.Member("guild", "me"); err != nil {
+		t.Errorf("State.Member returned error: %v", err)
+	}
+
+	n.ShardID = 1
+	n.ShardCount = 2
+	if err = n.Restore(&restored); err != ErrSnapshotShard {
+		t.Errorf("Restore into another shard returned %v, want %v", err, ErrSnapshotShard)
+	}
+}
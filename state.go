@@ -13,6 +13,7 @@
 package astatine
 
 import (
+	"encoding/json"
 	"errors"
 	"sort"
 	"sync"
@@ -42,20 +43,32 @@ type State struct {
 	TrackChannels      bool
 	TrackThreads       bool
 	TrackEmojis        bool
+	TrackStickers      bool
+	TrackStages        bool
 	TrackMembers       bool
 	TrackThreadMembers bool
 	TrackRoles         bool
 	TrackVoice         bool
 	TrackPresences     bool
-
-	guildMap   map[string]*Guild
-	channelMap map[string]*Channel
-	memberMap  map[string]map[string]*Member
+	TrackRelationships bool
+	TrackReadStates    bool
+	TrackUserSettings  bool
+	TrackNotes         bool
+	TrackMemberLists   bool
+
+	// Store holds the guilds, channels, members and presences of the state.
+	// NewState uses an in-memory store. The Guilds and PrivateChannels of
+	// Ready are refreshed from the store when guilds or private channels
+	// are added or removed, so with a store which returns copies they
+	// should be read through the methods of State instead.
+	Store StateStore
+
+	memberLists map[string]*MemberList
 }
 
 // NewState creates an empty state.
 func NewState() *State {
-	return &State{
+	s := &State{
 		Ready: Ready{
 			PrivateChannels: []*Channel{},
 			Guilds:          []*Guild{},
@@ -63,23 +76,22 @@ func NewState() *State {
 		TrackChannels:      true,
 		TrackThreads:       true,
 		TrackEmojis:        true,
+		TrackStickers:      true,
+		TrackStages:        true,
 		TrackMembers:       true,
 		TrackThreadMembers: true,
 		TrackRoles:         true,
 		TrackVoice:         true,
 		TrackPresences:     true,
-		guildMap:           make(map[string]*Guild),
-		channelMap:         make(map[string]*Channel),
-		memberMap:          make(map[string]map[string]*Member),
+		TrackRelationships: true,
+		TrackReadStates:    true,
+		TrackUserSettings:  true,
+		TrackNotes:         true,
+		TrackMemberLists:   true,
 	}
-}
+	s.Store = newMemoryStateStore()
 
-func (s *State) createMemberMap(guild *Guild) {
-	members := make(map[string]*Member)
-	for _, m := range guild.Members {
-		members[m.User.ID] = m
-	}
-	s.memberMap[guild.ID] = members
+	return s
 }
 
 // GuildAdd adds a guild to the current world state, or
@@ -92,25 +104,29 @@ func (s *State) GuildAdd(guild *Guild) error {
 	s.Lock()
 	defer s.Unlock()
 
-	// Update the channels to point to the right guild, adding them to the channelMap as we go
+	if err := s.guildAdd(guild); err != nil {
+		return err
+	}
+
+	return s.syncReady()
+}
+
+func (s *State) guildAdd(guild *Guild) error {
+	// Add the channels and threads to the store as we go
 	for _, c := range guild.Channels {
-		s.channelMap[c.ID] = c
+		if err := s.Store.ChannelAdd(c); err != nil {
+			return err
+		}
 	}
 
 	// Add all the threads to the state in case of thread sync list.
 	for _, t := range guild.Threads {
-		s.channelMap[t.ID] = t
-	}
-
-	// If this guild contains a new member slice, we must regenerate the member map so the pointers stay valid
-	if guild.Members != nil {
-		s.createMemberMap(guild)
-	} else if _, ok := s.memberMap[guild.ID]; !ok {
-		// Even if we have no new member slice, we still initialize the member map for this guild if it doesn't exist
-		s.memberMap[guild.ID] = make(map[string]*Member)
+		if err := s.Store.ChannelAdd(t); err != nil {
+			return err
+		}
 	}
 
-	if g, ok := s.guildMap[guild.ID]; ok {
+	if g, err := s.Store.Guild(guild.ID); err == nil {
 		// We are about to replace `g` in the state with `guild`, but first we need to
 		// make sure we preserve any fields that the `guild` doesn't contain from `g`.
 		if guild.MemberCount == 0 {
@@ -122,6 +138,9 @@ func (s *State) GuildAdd(guild *Guild) error {
 		if guild.Emojis == nil {
 			guild.Emojis = g.Emojis
 		}
+		if guild.Stickers == nil {
+			guild.Stickers = g.Stickers
+		}
 		if guild.Members == nil {
 			guild.Members = g.Members
 		}
@@ -134,17 +153,17 @@ func (s *State) GuildAdd(guild *Guild) error {
 		if guild.Threads == nil {
 			guild.Threads = g.Threads
 		}
+		if guild.StageInstances == nil {
+			guild.StageInstances = g.StageInstances
+		}
 		if guild.VoiceStates == nil {
 			guild.VoiceStates = g.VoiceStates
 		}
-		*g = *guild
-		return nil
+	} else if err != ErrStateNotFound {
+		return err
 	}
 
-	s.Guilds = append(s.Guilds, guild)
-	s.guildMap[guild.ID] = guild
-
-	return nil
+	return s.Store.GuildAdd(guild)
 }
 
 // GuildRemove removes a guild from current world state.
@@ -153,24 +172,24 @@ func (s *State) GuildRemove(guild *Guild) error {
 		return ErrNilState
 	}
 
-	_, err := s.Guild(guild.ID)
-	if err != nil {
-		return err
-	}
-
 	s.Lock()
 	defer s.Unlock()
 
-	delete(s.guildMap, guild.ID)
+	if err := s.Store.GuildRemove(guild.ID); err != nil {
+		return err
+	}
 
-	for i, g := range s.Guilds {
-		if g.ID == guild.ID {
-			s.Guilds = append(s.Guilds[:i], s.Guilds[i+1:]...)
-			return nil
-		}
+	return s.syncReady()
+}
+
+// syncReady refreshes the Guilds and PrivateChannels of Ready from the store.
+func (s *State) syncReady() (err error) {
+	if s.Guilds, err = s.Store.Guilds(); err != nil {
+		return err
 	}
 
-	return nil
+	s.PrivateChannels, err = s.Store.PrivateChannels()
+	return err
 }
 
 // Guild gets a guild by ID.
@@ -185,55 +204,59 @@ func (s *State) Guild(guildID string) (*Guild, error) {
 	s.RLock()
 	defer s.RUnlock()
 
-	if g, ok := s.guildMap[guildID]; ok {
-		return g, nil
+	return s.Store.Guild(guildID)
+}
+
+// guildMemberCountAdd adds delta to the MemberCount of a guild.
+func (s *State) guildMemberCountAdd(guildID string, delta int) error {
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return err
 	}
 
-	return nil, ErrStateNotFound
+	guild.MemberCount += delta
+	return s.Store.GuildAdd(guild)
 }
 
 func (s *State) presenceAdd(guildID string, presence *Presence) error {
-	guild, ok := s.guildMap[guildID]
-	if !ok {
-		return ErrStateNotFound
+	p, err := s.Store.Presence(guildID, presence.User.ID)
+	if err == ErrStateNotFound {
+		return s.Store.PresenceAdd(guildID, presence)
+	}
+	if err != nil {
+		return err
 	}
 
-	for i, p := range guild.Presences {
-		if p.User.ID == presence.User.ID {
-			//guild.Presences[i] = presence
-
-			//Update status
-			guild.Presences[i].Activities = presence.Activities
-			if presence.Status != "" {
-				guild.Presences[i].Status = presence.Status
-			}
-
-			//Update the optionally sent user information
-			//ID Is a mandatory field so you should not need to check if it is empty
-			guild.Presences[i].User.ID = presence.User.ID
+	//Update status
+	p.Activities = presence.Activities
+	if presence.Status != "" {
+		p.Status = presence.Status
+	}
 
-			if presence.User.Avatar != "" {
-				guild.Presences[i].User.Avatar = presence.User.Avatar
-			}
-			if presence.User.Discriminator != "" {
-				guild.Presences[i].User.Discriminator = presence.User.Discriminator
-			}
-			if presence.User.Email != "" {
-				guild.Presences[i].User.Email = presence.User.Email
-			}
-			if presence.User.Token != "" {
-				guild.Presences[i].User.Token = presence.User.Token
-			}
-			if presence.User.Username != "" {
-				guild.Presences[i].User.Username = presence.User.Username
-			}
+	//Update the optionally sent user information
+	//ID Is a mandatory field so you should not need to check if it is empty
+	p.User.ID = presence.User.ID
 
-			return nil
-		}
+	if presence.User.Avatar != "" {
+		p.User.Avatar = presence.User.Avatar
+	}
+	if presence.User.Discriminator != "" {
+		p.User.Discriminator = presence.User.Discriminator
+	}
+	if presence.User.Email != "" {
+		p.User.Email = presence.User.Email
+	}
+	if presence.User.Token != "" {
+		p.User.Token = presence.User.Token
+	}
+	if presence.User.Username != "" {
+		p.User.Username = presence.User.Username
 	}
 
-	guild.Presences = append(guild.Presences, presence)
-	return nil
+	return s.Store.PresenceAdd(guildID, p)
 }
 
 // PresenceAdd adds a presence to the current world state, or
@@ -255,22 +278,10 @@ func (s *State) PresenceRemove(guildID string, presence *Presence) error {
 		return ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
-	if err != nil {
-		return err
-	}
-
 	s.Lock()
 	defer s.Unlock()
 
-	for i, p := range guild.Presences {
-		if p.User.ID == presence.User.ID {
-			guild.Presences = append(guild.Presences[:i], guild.Presences[i+1:]...)
-			return nil
-		}
-	}
-
-	return ErrStateNotFound
+	return s.Store.PresenceRemove(guildID, presence.User.ID)
 }
 
 // Presence gets a presence by ID from a guild.
@@ -279,46 +290,29 @@ func (s *State) Presence(guildID, userID string) (*Presence, error) {
 		return nil, ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, p := range guild.Presences {
-		if p.User.ID == userID {
-			return p, nil
-		}
-	}
+	s.RLock()
+	defer s.RUnlock()
 
-	return nil, ErrStateNotFound
+	return s.Store.Presence(guildID, userID)
 }
 
 // TODO: Consider moving Guild state update methods onto *Guild.
 
 func (s *State) memberAdd(member *Member) error {
-	guild, ok := s.guildMap[member.GuildID]
-	if !ok {
-		return ErrStateNotFound
-	}
-
-	members, ok := s.memberMap[member.GuildID]
-	if !ok {
-		return ErrStateNotFound
-	}
-
-	m, ok := members[member.User.ID]
-	if !ok {
-		members[member.User.ID] = member
-		guild.Members = append(guild.Members, member)
-	} else {
+	m, err := s.Store.Member(member.GuildID, member.User.ID)
+	if err == nil {
 		// We are about to replace `m` in the state with `member`, but first we need to
 		// make sure we preserve any fields that the `member` doesn't contain from `m`.
 		if member.JoinedAt.IsZero() {
 			member.JoinedAt = m.JoinedAt
 		}
 		*m = *member
+		member = m
+	} else if err != ErrStateNotFound {
+		return err
 	}
-	return nil
+
+	return s.Store.MemberAdd(member)
 }
 
 // MemberAdd adds a member to the current world state, or
@@ -340,33 +334,10 @@ func (s *State) MemberRemove(member *Member) error {
 		return ErrNilState
 	}
 
-	guild, err := s.Guild(member.GuildID)
-	if err != nil {
-		return err
-	}
-
 	s.Lock()
 	defer s.Unlock()
 
-	members, ok := s.memberMap[member.GuildID]
-	if !ok {
-		return ErrStateNotFound
-	}
-
-	_, ok = members[member.User.ID]
-	if !ok {
-		return ErrStateNotFound
-	}
-	delete(members, member.User.ID)
-
-	for i, m := range guild.Members {
-		if m.User.ID == member.User.ID {
-			guild.Members = append(guild.Members[:i], guild.Members[i+1:]...)
-			return nil
-		}
-	}
-
-	return ErrStateNotFound
+	return s.Store.MemberRemove(member.GuildID, member.User.ID)
 }
 
 // Member gets a member by ID from a guild.
@@ -378,17 +349,7 @@ func (s *State) Member(guildID, userID string) (*Member, error) {
 	s.RLock()
 	defer s.RUnlock()
 
-	members, ok := s.memberMap[guildID]
-	if !ok {
-		return nil, ErrStateNotFound
-	}
-
-	m, ok := members[userID]
-	if ok {
-		return m, nil
-	}
-
-	return nil, ErrStateNotFound
+	return s.Store.Member(guildID, userID)
 }
 
 // RoleAdd adds a role to the current world state, or
@@ -398,23 +359,23 @@ func (s *State) RoleAdd(guildID string, role *Role) error {
 		return ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
-
 	for i, r := range guild.Roles {
 		if r.ID == role.ID {
 			guild.Roles[i] = role
-			return nil
+			return s.Store.GuildAdd(guild)
 		}
 	}
 
 	guild.Roles = append(guild.Roles, role)
-	return nil
+	return s.Store.GuildAdd(guild)
 }
 
 // RoleRemove removes a role from current world state by ID.
@@ -423,18 +384,18 @@ func (s *State) RoleRemove(guildID, roleID string) error {
 		return ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
-
 	for i, r := range guild.Roles {
 		if r.ID == roleID {
 			guild.Roles = append(guild.Roles[:i], guild.Roles[i+1:]...)
-			return nil
+			return s.Store.GuildAdd(guild)
 		}
 	}
 
@@ -447,14 +408,14 @@ func (s *State) Role(guildID, roleID string) (*Role, error) {
 		return nil, ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return nil, err
 	}
 
-	s.RLock()
-	defer s.RUnlock()
-
 	for _, r := range guild.Roles {
 		if r.ID == roleID {
 			return r, nil
@@ -476,8 +437,16 @@ func (s *State) ChannelAdd(channel *Channel) error {
 	s.Lock()
 	defer s.Unlock()
 
+	if err := s.channelAdd(channel); err != nil {
+		return err
+	}
+
+	return s.syncReady()
+}
+
+func (s *State) channelAdd(channel *Channel) error {
 	// If the channel exists, replace it
-	if c, ok := s.channelMap[channel.ID]; ok {
+	if c, err := s.Store.Channel(channel.ID); err == nil {
 		if channel.Messages == nil {
 			channel.Messages = c.Messages
 		}
@@ -489,29 +458,58 @@ func (s *State) ChannelAdd(channel *Channel) error {
 		}
 
 		*c = *channel
-		return nil
+		if err = s.Store.ChannelAdd(c); err != nil {
+			return err
+		}
+
+		// Channels of guilds which aren't tracked are still stored.
+		if c.GuildID == "" {
+			return nil
+		}
+		if err = s.guildChannelSet(c); err == ErrStateNotFound {
+			return nil
+		}
+		return err
+	} else if err != ErrStateNotFound {
+		return err
 	}
 
 	if channel.Type == ChannelTypeDM || channel.Type == ChannelTypeGroupDM {
-		s.PrivateChannels = append(s.PrivateChannels, channel)
-		s.channelMap[channel.ID] = channel
-		return nil
+		return s.Store.ChannelAdd(channel)
 	}
 
-	guild, ok := s.guildMap[channel.GuildID]
-	if !ok {
-		return ErrStateNotFound
+	if err := s.guildChannelSet(channel); err != nil {
+		return err
+	}
+
+	return s.Store.ChannelAdd(channel)
+}
+
+// guildChannelSet adds a channel to the Channels or Threads of its guild,
+// or replaces it there if it is already listed.
+func (s *State) guildChannelSet(channel *Channel) error {
+	guild, err := s.Store.Guild(channel.GuildID)
+	if err != nil {
+		return err
 	}
 
+	channels := &guild.Channels
 	if channel.IsThread() {
-		guild.Threads = append(guild.Threads, channel)
-	} else {
-		guild.Channels = append(guild.Channels, channel)
+		channels = &guild.Threads
 	}
 
-	s.channelMap[channel.ID] = channel
+	for i, c := range *channels {
+		if c.ID == channel.ID {
+			if c == channel {
+				return nil
+			}
+			(*channels)[i] = channel
+			return s.Store.GuildAdd(guild)
+		}
+	}
 
-	return nil
+	*channels = append(*channels, channel)
+	return s.Store.GuildAdd(guild)
 }
 
 // ChannelRemove removes a channel from current world state.
@@ -520,64 +518,57 @@ func (s *State) ChannelRemove(channel *Channel) error {
 		return ErrNilState
 	}
 
-	_, err := s.Channel(channel.ID)
-	if err != nil {
-		return err
-	}
-
-	if channel.Type == ChannelTypeDM || channel.Type == ChannelTypeGroupDM {
-		s.Lock()
-		defer s.Unlock()
-
-		for i, c := range s.PrivateChannels {
-			if c.ID == channel.ID {
-				s.PrivateChannels = append(s.PrivateChannels[:i], s.PrivateChannels[i+1:]...)
-				break
-			}
-		}
-		delete(s.channelMap, channel.ID)
-		return nil
-	}
+	s.Lock()
+	defer s.Unlock()
 
-	guild, err := s.Guild(channel.GuildID)
-	if err != nil {
+	if _, err := s.Store.Channel(channel.ID); err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
+	if channel.Type != ChannelTypeDM && channel.Type != ChannelTypeGroupDM {
+		guild, err := s.Store.Guild(channel.GuildID)
+		if err != nil {
+			return err
+		}
 
-	if channel.IsThread() {
-		for i, t := range guild.Threads {
-			if t.ID == channel.ID {
-				guild.Threads = append(guild.Threads[:i], guild.Threads[i+1:]...)
-				break
+		if channel.IsThread() {
+			for i, t := range guild.Threads {
+				if t.ID == channel.ID {
+					guild.Threads = append(guild.Threads[:i], guild.Threads[i+1:]...)
+					break
+				}
 			}
-		}
-	} else {
-		for i, c := range guild.Channels {
-			if c.ID == channel.ID {
-				guild.Channels = append(guild.Channels[:i], guild.Channels[i+1:]...)
-				break
+		} else {
+			for i, c := range guild.Channels {
+				if c.ID == channel.ID {
+					guild.Channels = append(guild.Channels[:i], guild.Channels[i+1:]...)
+					break
+				}
 			}
 		}
+
+		if err = s.Store.GuildAdd(guild); err != nil {
+			return err
+		}
 	}
 
-	delete(s.channelMap, channel.ID)
+	if err := s.Store.ChannelRemove(channel.ID); err != nil {
+		return err
+	}
 
-	return nil
+	return s.syncReady()
 }
 
 // ThreadListSync syncs guild threads with provided ones.
 func (s *State) ThreadListSync(tls *ThreadListSync) error {
-	guild, err := s.Guild(tls.GuildID)
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(tls.GuildID)
 	if err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
-
 	// This algorithm filters out archived or
 	// threads which are children of channels in channelIDs
 	// and then it adds all synced threads to guild threads and cache
@@ -587,25 +578,34 @@ outer:
 		if !t.ThreadMetadata.Archived && tls.ChannelIDs != nil {
 			for _, v := range tls.ChannelIDs {
 				if t.ParentID == v {
-					delete(s.channelMap, t.ID)
+					s.Store.ChannelRemove(t.ID)
 					continue outer
 				}
 			}
 			guild.Threads[index] = t
 			index++
 		} else {
-			delete(s.channelMap, t.ID)
+			s.Store.ChannelRemove(t.ID)
 		}
 	}
 	guild.Threads = guild.Threads[:index]
 	for _, t := range tls.Threads {
-		s.channelMap[t.ID] = t
+		if err = s.Store.ChannelAdd(t); err != nil {
+			return err
+		}
 		guild.Threads = append(guild.Threads, t)
 	}
 
+	if err = s.Store.GuildAdd(guild); err != nil {
+		return err
+	}
+
 	for _, m := range tls.Members {
-		if c, ok := s.channelMap[m.ID]; ok {
+		if c, err := s.Store.Channel(m.ID); err == nil {
 			c.Member = m
+			if err = s.Store.ChannelAdd(c); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -614,12 +614,13 @@ outer:
 
 // ThreadMembersUpdate updates thread members list
 func (s *State) ThreadMembersUpdate(tmu *ThreadMembersUpdate) error {
-	thread, err := s.Channel(tmu.ID)
+	s.Lock()
+	defer s.Unlock()
+
+	thread, err := s.Store.Channel(tmu.ID)
 	if err != nil {
 		return err
 	}
-	s.Lock()
-	defer s.Unlock()
 
 	for idx, member := range thread.Members {
 		for _, removedMember := range tmu.RemovedMembers {
@@ -647,18 +648,21 @@ func (s *State) ThreadMembersUpdate(tmu *ThreadMembersUpdate) error {
 	}
 	thread.MemberCount = tmu.MemberCount
 
-	return nil
+	return s.Store.ChannelAdd(thread)
 }
 
 // ThreadMemberUpdate sets or updates member data for the current user.
 func (s *State) ThreadMemberUpdate(mu *ThreadMemberUpdate) error {
-	thread, err := s.Channel(mu.ID)
+	s.Lock()
+	defer s.Unlock()
+
+	thread, err := s.Store.Channel(mu.ID)
 	if err != nil {
 		return err
 	}
 
 	thread.Member = mu.ThreadMember
-	return nil
+	return s.Store.ChannelAdd(thread)
 }
 
 // GuildChannel gets a channel by ID from a guild.
@@ -682,11 +686,7 @@ func (s *State) Channel(channelID string) (*Channel, error) {
 	s.RLock()
 	defer s.RUnlock()
 
-	if c, ok := s.channelMap[channelID]; ok {
-		return c, nil
-	}
-
-	return nil, ErrStateNotFound
+	return s.Store.Channel(channelID)
 }
 
 // Emoji returns an emoji for a guild and emoji id.
@@ -695,14 +695,14 @@ func (s *State) Emoji(guildID, emojiID string) (*Emoji, error) {
 		return nil, ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return nil, err
 	}
 
-	s.RLock()
-	defer s.RUnlock()
-
 	for _, e := range guild.Emojis {
 		if e.ID == emojiID {
 			return e, nil
@@ -718,23 +718,23 @@ func (s *State) EmojiAdd(guildID string, emoji *Emoji) error {
 		return ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
-
 	for i, e := range guild.Emojis {
 		if e.ID == emoji.ID {
 			guild.Emojis[i] = emoji
-			return nil
+			return s.Store.GuildAdd(guild)
 		}
 	}
 
 	guild.Emojis = append(guild.Emojis, emoji)
-	return nil
+	return s.Store.GuildAdd(guild)
 }
 
 // EmojisAdd adds multiple emojis to the world state.
@@ -747,28 +747,175 @@ func (s *State) EmojisAdd(guildID string, emojis []*Emoji) error {
 	return nil
 }
 
-// MessageAdd adds a message to the current world state, or updates it if it exists.
-// If the channel cannot be found, the message is discarded.
-// Messages are kept in state up to s.MaxMessageCount per channel.
-func (s *State) MessageAdd(message *Message) error {
+// Sticker returns a sticker for a guild and sticker id.
+func (s *State) Sticker(guildID, stickerID string) (*Sticker, error) {
 	if s == nil {
-		return ErrNilState
+		return nil, ErrNilState
 	}
 
-	c, err := s.Channel(message.ChannelID)
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	s.Lock()
-	defer s.Unlock()
+	for _, st := range guild.Stickers {
+		if st.ID == stickerID {
+			return st, nil
+		}
+	}
 
-	// If the message exists, merge in the new message contents.
-	for _, m := range c.Messages {
-		if m.ID == message.ID {
-			if message.Content != "" {
-				m.Content = message.Content
-			}
+	return nil, ErrStateNotFound
+}
+
+// StickerAdd adds a sticker to the current world state.
+func (s *State) StickerAdd(guildID string, sticker *Sticker) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return err
+	}
+
+	for i, st := range guild.Stickers {
+		if st.ID == sticker.ID {
+			guild.Stickers[i] = sticker
+			return s.Store.GuildAdd(guild)
+		}
+	}
+
+	guild.Stickers = append(guild.Stickers, sticker)
+	return s.Store.GuildAdd(guild)
+}
+
+// StickersAdd adds multiple stickers to the world state.
+func (s *State) StickersAdd(guildID string, stickers []*Sticker) error {
+	for _, st := range stickers {
+		if err := s.StickerAdd(guildID, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stickersUpdate replaces the stickers of a guild. GUILD_STICKERS_UPDATE
+// holds every sticker of the guild, so stickers missing from it were
+// deleted.
+func (s *State) stickersUpdate(guildID string, stickers []*Sticker) error {
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return err
+	}
+
+	guild.Stickers = stickers
+	return s.Store.GuildAdd(guild)
+}
+
+// StageInstanceAdd adds a stage instance to the current world state, or
+// updates it if it already exists.
+func (s *State) StageInstanceAdd(stage *StageInstance) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(stage.GuildID)
+	if err != nil {
+		return err
+	}
+
+	for i, st := range guild.StageInstances {
+		if st.ID == stage.ID {
+			guild.StageInstances[i] = stage
+			return s.Store.GuildAdd(guild)
+		}
+	}
+
+	guild.StageInstances = append(guild.StageInstances, stage)
+	return s.Store.GuildAdd(guild)
+}
+
+// StageInstanceRemove removes a stage instance from current world state.
+func (s *State) StageInstanceRemove(stage *StageInstance) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(stage.GuildID)
+	if err != nil {
+		return err
+	}
+
+	for i, st := range guild.StageInstances {
+		if st.ID == stage.ID {
+			guild.StageInstances = append(guild.StageInstances[:i], guild.StageInstances[i+1:]...)
+			return s.Store.GuildAdd(guild)
+		}
+	}
+
+	return ErrStateNotFound
+}
+
+// StageInstance returns the live stage instance of a stage channel.
+func (s *State) StageInstance(guildID, channelID string) (*StageInstance, error) {
+	if s == nil {
+		return nil, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, st := range guild.StageInstances {
+		if st.ChannelID == channelID {
+			return st, nil
+		}
+	}
+
+	return nil, ErrStateNotFound
+}
+
+// MessageAdd adds a message to the current world state, or updates it if it exists.
+// If the channel cannot be found, the message is discarded.
+// Messages are kept in state up to s.MaxMessageCount per channel.
+func (s *State) MessageAdd(message *Message) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	messages, err := s.Store.Messages(message.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	// If the message exists, merge in the new message contents.
+	for _, m := range messages {
+		if m.ID == message.ID {
+			if message.Content != "" {
+				m.Content = message.Content
+			}
 			if message.EditedTimestamp != nil {
 				m.EditedTimestamp = message.EditedTimestamp
 			}
@@ -791,17 +938,11 @@ func (s *State) MessageAdd(message *Message) error {
 				m.Components = message.Components
 			}
 
-			return nil
+			return s.Store.MessageAdd(m, s.MaxMessageCount)
 		}
 	}
 
-	c.Messages = append(c.Messages, message)
-
-	if len(c.Messages) > s.MaxMessageCount {
-		c.Messages = c.Messages[len(c.Messages)-s.MaxMessageCount:]
-	}
-
-	return nil
+	return s.Store.MessageAdd(message, s.MaxMessageCount)
 }
 
 // MessageRemove removes a message from the world state.
@@ -815,51 +956,39 @@ func (s *State) MessageRemove(message *Message) error {
 
 // messageRemoveByID removes a message by channelID and messageID from the world state.
 func (s *State) messageRemoveByID(channelID, messageID string) error {
-	c, err := s.Channel(channelID)
-	if err != nil {
-		return err
-	}
-
 	s.Lock()
 	defer s.Unlock()
 
-	for i, m := range c.Messages {
-		if m.ID == messageID {
-			c.Messages = append(c.Messages[:i], c.Messages[i+1:]...)
-
-			return nil
-		}
-	}
-
-	return ErrStateNotFound
+	return s.Store.MessageRemove(channelID, messageID)
 }
 
 func (s *State) voiceStateUpdate(update *VoiceStateUpdate) error {
-	guild, err := s.Guild(update.GuildID)
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(update.GuildID)
 	if err != nil {
 		return err
 	}
 
-	s.Lock()
-	defer s.Unlock()
-
 	// Handle Leaving Channel
 	if update.ChannelID == "" {
 		for i, state := range guild.VoiceStates {
 			if state.UserID == update.UserID {
 				guild.VoiceStates = append(guild.VoiceStates[:i], guild.VoiceStates[i+1:]...)
-				return nil
+				return s.Store.GuildAdd(guild)
 			}
 		}
 	} else {
 		for i, state := range guild.VoiceStates {
 			if state.UserID == update.UserID {
 				guild.VoiceStates[i] = update.VoiceState
-				return nil
+				return s.Store.GuildAdd(guild)
 			}
 		}
 
 		guild.VoiceStates = append(guild.VoiceStates, update.VoiceState)
+		return s.Store.GuildAdd(guild)
 	}
 
 	return nil
@@ -871,7 +1000,10 @@ func (s *State) VoiceState(guildID, userID string) (*VoiceState, error) {
 		return nil, ErrNilState
 	}
 
-	guild, err := s.Guild(guildID)
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
 	if err != nil {
 		return nil, err
 	}
@@ -891,15 +1023,15 @@ func (s *State) Message(channelID, messageID string) (*Message, error) {
 		return nil, ErrNilState
 	}
 
-	c, err := s.Channel(channelID)
+	s.RLock()
+	defer s.RUnlock()
+
+	messages, err := s.Store.Messages(channelID)
 	if err != nil {
 		return nil, err
 	}
 
-	s.RLock()
-	defer s.RUnlock()
-
-	for _, m := range c.Messages {
+	for _, m := range messages {
 		if m.ID == messageID {
 			return m, nil
 		}
@@ -928,22 +1060,573 @@ func (s *State) onReady(se *Session, r *Ready) (err error) {
 
 		s.Ready = ready
 
-		return nil
+		return s.syncReady()
 	}
 
 	s.Ready = *r
 
-	for _, g := range s.Guilds {
-		s.guildMap[g.ID] = g
-		s.createMemberMap(g)
+	// Remove the guilds of this session which are no longer in the READY.
+	// Shards sharing a State only receive their own guilds, so the guilds
+	// of the other shards are kept.
+	guilds, err := s.Store.Guilds()
+	if err != nil {
+		return err
+	}
+	ready := make(map[string]bool, len(r.Guilds))
+	for _, g := range r.Guilds {
+		ready[g.ID] = true
+	}
+	for _, g := range append([]*Guild(nil), guilds...) {
+		if !ready[g.ID] && GuildShardID(g.ID, se.ShardCount) == se.ShardID {
+			if err = s.Store.GuildRemove(g.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, g := range r.Guilds {
+		if err = s.guildAdd(g); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range r.PrivateChannels {
+		if err = s.channelAdd(c); err != nil {
+			return err
+		}
+	}
+
+	return s.syncReady()
+}
+
+// Relationship gets the relationship of the current user with a user.
+func (s *State) Relationship(userID string) (*Relationship, error) {
+	if s == nil {
+		return nil, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	for _, r := range s.Relationships {
+		if r.ID == userID {
+			return r, nil
+		}
+	}
+
+	return nil, ErrStateNotFound
+}
+
+// RelationshipAdd adds a relationship to the current world state, or
+// updates it if it already exists.
+func (s *State) RelationshipAdd(relationship *Relationship) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	for i, r := range s.Relationships {
+		if r.ID == relationship.ID {
+			if relationship.User == nil {
+				relationship.User = r.User
+			}
+			s.Relationships[i] = relationship
+			return nil
+		}
+	}
+
+	s.Relationships = append(s.Relationships, relationship)
+	return nil
+}
+
+// RelationshipRemove removes the relationship with a user from the current
+// world state.
+func (s *State) RelationshipRemove(userID string) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	for i, r := range s.Relationships {
+		if r.ID == userID {
+			s.Relationships = append(s.Relationships[:i], s.Relationships[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrStateNotFound
+}
+
+// ChannelReadState gets the read state of a channel.
+func (s *State) ChannelReadState(channelID string) (*ReadState, error) {
+	if s == nil {
+		return nil, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if r := s.readState(channelID); r != nil {
+		return r, nil
+	}
+
+	return nil, ErrStateNotFound
+}
+
+// readState returns the read state of a channel, or nil.
+func (s *State) readState(channelID string) *ReadState {
+	for _, r := range s.ReadState {
+		if r.ID == channelID {
+			return r
+		}
+	}
+	return nil
+}
+
+// readStateAck marks a channel as read up to a message.
+func (s *State) readStateAck(channelID, messageID string) {
+	r := s.readState(channelID)
+	if r == nil {
+		r = &ReadState{ID: channelID}
+		s.ReadState = append(s.ReadState, r)
+	}
+
+	r.LastMessageID = messageID
+	r.MentionCount = 0
+}
+
+// channelAck marks a channel as read up to a message, after it was acked
+// through the REST API.
+func (s *State) channelAck(channelID, messageID string) {
+	if s == nil || !s.TrackReadStates {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.readStateAck(channelID, messageID)
+}
+
+// guildAck marks every channel of a guild as read up to its last message,
+// after the guild was acked through the REST API.
+func (s *State) guildAck(guildID string) {
+	if s == nil || !s.TrackReadStates {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return
+	}
+
+	for _, channels := range [][]*Channel{guild.Channels, guild.Threads} {
+		for _, c := range channels {
+			if c.LastMessageID != "" {
+				s.readStateAck(c.ID, c.LastMessageID)
+			}
+		}
+	}
+}
+
+// readStateMessageAdd updates read states for a new message. Messages of the
+// current user are read, others raise the mention count when they mention
+// the current user.
+func (s *State) readStateMessageAdd(message *Message) error {
+	s.Lock()
+	defer s.Unlock()
+
+	channel, err := s.Store.Channel(message.ChannelID)
+	if err == nil {
+		channel.LastMessageID = message.ID
+		if err = s.Store.ChannelAdd(channel); err != nil {
+			return err
+		}
+	} else if err != ErrStateNotFound {
+		return err
+	}
+
+	if s.User == nil || message.Author == nil {
+		return nil
+	}
+
+	if message.Author.ID == s.User.ID {
+		s.readStateAck(message.ChannelID, message.ID)
+		return nil
+	}
+
+	if s.messageMentions(message) {
+		r := s.readState(message.ChannelID)
+		if r == nil {
+			r = &ReadState{ID: message.ChannelID}
+			s.ReadState = append(s.ReadState, r)
+		}
+		r.MentionCount++
+	}
+
+	return nil
+}
+
+// messageMentions returns whether a message mentions the current user.
+func (s *State) messageMentions(message *Message) bool {
+	if message.MentionEveryone {
+		return true
+	}
+
+	for _, u := range message.Mentions {
+		if u.ID == s.User.ID {
+			return true
+		}
+	}
+
+	if message.GuildID == "" || len(message.MentionRoles) == 0 {
+		return false
+	}
+
+	member, err := s.Store.Member(message.GuildID, s.User.ID)
+	if err != nil {
+		return false
+	}
+
+	for _, roleID := range message.MentionRoles {
+		for _, r := range member.Roles {
+			if r == roleID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ChannelUnread returns whether a channel has messages which the current user
+// hasn't read.
+func (s *State) ChannelUnread(channelID string) (bool, error) {
+	if s == nil {
+		return false, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.channelUnread(channelID)
+}
+
+func (s *State) channelUnread(channelID string) (bool, error) {
+	channel, err := s.Store.Channel(channelID)
+	if err != nil {
+		return false, err
+	}
+
+	if channel.LastMessageID == "" {
+		return false, nil
+	}
+
+	r := s.readState(channelID)
+	return r == nil || snowflakeLess(r.LastMessageID, channel.LastMessageID), nil
+}
+
+// ChannelMentionCount returns the number of unread mentions of the current
+// user in a channel. ErrStateNotFound is returned if neither the channel nor
+// its read state is in the state.
+func (s *State) ChannelMentionCount(channelID string) (int, error) {
+	if s == nil {
+		return 0, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if r := s.readState(channelID); r != nil {
+		return r.MentionCount, nil
+	}
+
+	if _, err := s.Store.Channel(channelID); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+// GuildUnread returns whether any channel or thread of a guild has messages
+// which the current user hasn't read.
+func (s *State) GuildUnread(guildID string) (bool, error) {
+	if s == nil {
+		return false, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, channels := range [][]*Channel{guild.Channels, guild.Threads} {
+		for _, c := range channels {
+			if unread, err := s.channelUnread(c.ID); err == nil && unread {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// GuildMentionCount returns the number of unread mentions of the current
+// user in all channels and threads of a guild.
+func (s *State) GuildMentionCount(guildID string) (int, error) {
+	if s == nil {
+		return 0, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	guild, err := s.Store.Guild(guildID)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, channels := range [][]*Channel{guild.Channels, guild.Threads} {
+		for _, c := range channels {
+			if r := s.readState(c.ID); r != nil {
+				count += r.MentionCount
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// GuildSettings gets the notification settings of the current user in a
+// guild. The settings of direct messages have an empty guild ID.
+func (s *State) GuildSettings(guildID string) (*UserGuildSettings, error) {
+	if s == nil {
+		return nil, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	for _, g := range s.UserGuildSettings {
+		if g.GuildID == guildID {
+			return g, nil
+		}
+	}
+
+	return nil, ErrStateNotFound
+}
+
+// GuildSettingsAdd adds guild settings to the current world state, or
+// replaces them if they already exist.
+func (s *State) GuildSettingsAdd(settings *UserGuildSettings) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
 
-		for _, c := range g.Channels {
-			s.channelMap[c.ID] = c
+	for i, g := range s.UserGuildSettings {
+		if g.GuildID == settings.GuildID {
+			s.UserGuildSettings[i] = settings
+			return nil
 		}
 	}
 
-	for _, c := range s.PrivateChannels {
-		s.channelMap[c.ID] = c
+	s.UserGuildSettings = append(s.UserGuildSettings, settings)
+	return nil
+}
+
+// userSettingsUpdate applies the changed fields of a UserSettingsUpdate onto
+// the settings of the current user.
+func (s *State) userSettingsUpdate(update UserSettingsUpdate) error {
+	s.Lock()
+	defer s.Unlock()
+
+	b, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	if s.Settings == nil {
+		s.Settings = &Settings{}
+	}
+	return json.Unmarshal(b, s.Settings)
+}
+
+// Note gets the note of the current user on a user.
+func (s *State) Note(userID string) (string, error) {
+	if s == nil {
+		return "", ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if note, ok := s.Notes[userID]; ok {
+		return note, nil
+	}
+
+	return "", ErrStateNotFound
+}
+
+// NoteSet sets the note of the current user on a user in the current world
+// state. An empty note removes it.
+func (s *State) NoteSet(userID, note string) error {
+	if s == nil {
+		return ErrNilState
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if note == "" {
+		delete(s.Notes, userID)
+		return nil
+	}
+
+	if s.Notes == nil {
+		s.Notes = make(map[string]string)
+	}
+	s.Notes[userID] = note
+	return nil
+}
+
+// MemberList gets a member list of a guild by ID.
+func (s *State) MemberList(guildID, listID string) (*MemberList, error) {
+	if s == nil {
+		return nil, ErrNilState
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if l, ok := s.memberLists[guildID+"/"+listID]; ok {
+		return l, nil
+	}
+
+	return nil, ErrStateNotFound
+}
+
+// memberListUpdate applies the operations of a GuildMemberListUpdate to its
+// member list. The members and presences of the list are also added to the
+// guild when they are tracked.
+func (s *State) memberListUpdate(u *GuildMemberListUpdate) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.memberLists == nil {
+		s.memberLists = make(map[string]*MemberList)
+	}
+
+	l, ok := s.memberLists[u.GuildID+"/"+u.ID]
+	if !ok {
+		l = &MemberList{ID: u.ID, GuildID: u.GuildID}
+		s.memberLists[u.GuildID+"/"+u.ID] = l
+	}
+
+	l.MemberCount = u.MemberCount
+	l.OnlineCount = u.OnlineCount
+	l.Groups = u.Groups
+
+	for _, op := range u.Ops {
+		// Skip malformed operations, which would be out of bounds.
+		if !op.valid() {
+			continue
+		}
+
+		switch op.Op {
+		case MemberListOpSync:
+			for i, item := range op.Items {
+				l.set(op.Range[0]+i, item)
+			}
+			if err := s.memberListItemsAdd(u.GuildID, op.Items...); err != nil {
+				return err
+			}
+		case MemberListOpInvalidate:
+			for i := op.Range[0]; i <= op.Range[1] && i < len(l.Items); i++ {
+				l.Items[i] = nil
+			}
+		case MemberListOpInsert:
+			if op.Index < len(l.Items) {
+				l.Items = append(l.Items, nil)
+				copy(l.Items[op.Index+1:], l.Items[op.Index:])
+			}
+			l.set(op.Index, op.Item)
+			if err := s.memberListItemsAdd(u.GuildID, op.Item); err != nil {
+				return err
+			}
+		case MemberListOpUpdate:
+			l.set(op.Index, op.Item)
+			if err := s.memberListItemsAdd(u.GuildID, op.Item); err != nil {
+				return err
+			}
+		case MemberListOpDelete:
+			if op.Index < len(l.Items) {
+				l.Items = append(l.Items[:op.Index], l.Items[op.Index+1:]...)
+			}
+		}
+	}
+
+	return nil
+}
+
+// valid reports whether the index or range of an operation can be applied.
+func (op *MemberListOp) valid() bool {
+	if op == nil {
+		return false
+	}
+
+	switch op.Op {
+	case MemberListOpSync, MemberListOpInvalidate:
+		return op.Range[0] >= 0 && op.Range[0] <= op.Range[1]
+	default:
+		return op.Index >= 0
+	}
+}
+
+// set sets the item at index i, growing the list if needed.
+func (l *MemberList) set(i int, item *MemberListItem) {
+	for len(l.Items) <= i {
+		l.Items = append(l.Items, nil)
+	}
+	l.Items[i] = item
+}
+
+// memberListItemsAdd adds the members and presences of member list items to
+// their guild.
+func (s *State) memberListItemsAdd(guildID string, items ...*MemberListItem) error {
+	for _, item := range items {
+		if item == nil || item.Member == nil || item.Member.Member == nil || item.Member.User == nil {
+			continue
+		}
+
+		m := item.Member.Member
+		m.GuildID = guildID
+		if s.TrackMembers {
+			if err := s.memberAdd(m); err != nil && err != ErrStateNotFound {
+				return err
+			}
+		}
+
+		if p := item.Member.Presence; p != nil && s.TrackPresences {
+			if p.User == nil || p.User.ID == "" {
+				p.User = m.User
+			}
+			if err := s.presenceAdd(guildID, p); err != nil && err != ErrStateNotFound {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -980,11 +1663,10 @@ func (s *State) OnInterface(se *Session, i interface{}) (err error) {
 		err = s.GuildRemove(t.Guild)
 	case *GuildMemberAdd:
 		// Updates the MemberCount of the guild.
-		guild, err := s.Guild(t.Member.GuildID)
+		err = s.guildMemberCountAdd(t.Member.GuildID, 1)
 		if err != nil {
 			return err
 		}
-		guild.MemberCount++
 
 		// Caches member if tracking is enabled.
 		if s.TrackMembers {
@@ -996,11 +1678,10 @@ func (s *State) OnInterface(se *Session, i interface{}) (err error) {
 		}
 	case *GuildMemberRemove:
 		// Updates the MemberCount of the guild.
-		guild, err := s.Guild(t.Member.GuildID)
+		err = s.guildMemberCountAdd(t.Member.GuildID, -1)
 		if err != nil {
 			return err
 		}
-		guild.MemberCount--
 
 		// Removes member from the cache if tracking is enabled.
 		if s.TrackMembers {
@@ -1035,6 +1716,22 @@ func (s *State) OnInterface(se *Session, i interface{}) (err error) {
 		if s.TrackEmojis {
 			err = s.EmojisAdd(t.GuildID, t.Emojis)
 		}
+	case *StageInstanceCreate:
+		if s.TrackStages {
+			err = s.StageInstanceAdd(t.StageInstance)
+		}
+	case *StageInstanceUpdate:
+		if s.TrackStages {
+			err = s.StageInstanceAdd(t.StageInstance)
+		}
+	case *StageInstanceDelete:
+		if s.TrackStages {
+			err = s.StageInstanceRemove(t.StageInstance)
+		}
+	case *GuildStickersUpdate:
+		if s.TrackStickers {
+			err = s.stickersUpdate(t.GuildID, t.Stickers)
+		}
 	case *ChannelCreate:
 		if s.TrackChannels {
 			err = s.ChannelAdd(t.Channel)
@@ -1080,6 +1777,19 @@ func (s *State) OnInterface(se *Session, i interface{}) (err error) {
 		if s.MaxMessageCount != 0 {
 			err = s.MessageAdd(t.Message)
 		}
+		// Read states are tracked even when the message isn't stored,
+		// e.g. because its channel isn't in the state.
+		if s.TrackReadStates {
+			if err2 := s.readStateMessageAdd(t.Message); err == nil {
+				err = err2
+			}
+		}
+	case *MessageAck:
+		if s.TrackReadStates {
+			s.Lock()
+			s.readStateAck(t.ChannelID, t.MessageID)
+			s.Unlock()
+		}
 	case *MessageUpdate:
 		if s.MaxMessageCount != 0 {
 			var old *Message
@@ -1119,6 +1829,30 @@ func (s *State) OnInterface(se *Session, i interface{}) (err error) {
 
 			err = s.voiceStateUpdate(t)
 		}
+	case *RelationshipAdd:
+		if s.TrackRelationships {
+			err = s.RelationshipAdd(t.Relationship)
+		}
+	case *RelationshipRemove:
+		if s.TrackRelationships {
+			err = s.RelationshipRemove(t.ID)
+		}
+	case *UserSettingsUpdate:
+		if s.TrackUserSettings {
+			err = s.userSettingsUpdate(*t)
+		}
+	case *UserGuildSettingsUpdate:
+		if s.TrackUserSettings {
+			err = s.GuildSettingsAdd(t.UserGuildSettings)
+		}
+	case *UserNoteUpdate:
+		if s.TrackNotes {
+			err = s.NoteSet(t.ID, t.Note)
+		}
+	case *GuildMemberListUpdate:
+		if s.TrackMemberLists {
+			err = s.memberListUpdate(t)
+		}
 	case *PresenceUpdate:
 		if s.TrackPresences {
 			s.PresenceAdd(t.GuildID, &t.Presence)
@@ -0,0 +1,337 @@
+package astatine
+
+import (
+	"encoding/json"
+	netHttp "net/http"
+	"testing"
+)
+
+func TestStateRelationships(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	state.OnInterface(s, &Ready{Relationships: []*Relationship{
+		{ID: "1", Type: RelationshipTypeIncomingRequest, User: &User{ID: "1"}},
+	}})
+	state.OnInterface(s, &RelationshipAdd{&Relationship{ID: "1", Type: RelationshipTypeFriend}})
+	state.OnInterface(s, &RelationshipAdd{&Relationship{ID: "2", Type: RelationshipTypeBlocked, User: &User{ID: "2"}}})
+
+	r, err := state.Relationship("1")
+	if err != nil {
+		t.Fatalf("Relationship returned error: %v", err)
+	}
+	if r.Type != RelationshipTypeFriend || r.User == nil {
+		t.Errorf("relationship was not updated: %+v", r)
+	}
+
+	state.OnInterface(s, &RelationshipRemove{&Relationship{ID: "2", Type: RelationshipTypeBlocked}})
+	if _, err = state.Relationship("2"); err != ErrStateNotFound {
+		t.Errorf("Relationship returned %v, want %v", err, ErrStateNotFound)
+	}
+	if len(state.Relationships) != 1 {
+		t.Errorf("State has %d relationships, want 1", len(state.Relationships))
+	}
+}
+
+func TestStateReadStates(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	state.OnInterface(s, &Ready{
+		User:      &User{ID: "me"},
+		ReadState: ReadStates{{ID: "channel", LastMessageID: "10"}},
+	})
+	state.OnInterface(s, &GuildCreate{&Guild{
+		ID:       "guild",
+		Channels: []*Channel{{ID: "channel", GuildID: "guild", LastMessageID: "10"}},
+		Threads:  []*Channel{{ID: "thread", GuildID: "guild", Type: ChannelTypeGuildPublicThread}},
+	}})
+
+	if unread, _ := state.GuildUnread("guild"); unread {
+		t.Error("guild is unread before any new message")
+	}
+
+	state.OnInterface(s, &MessageCreate{&Message{ID: "11", ChannelID: "channel", GuildID: "guild", Author: &User{ID: "other"}, Mentions: []*User{{ID: "me"}}}})
+	state.OnInterface(s, &MessageCreate{&Message{ID: "12", ChannelID: "channel", GuildID: "guild", Author: &User{ID: "other"}}})
+
+	if unread, _ := state.ChannelUnread("channel"); !unread {
+		t.Error("channel is not unread after new messages")
+	}
+	if count, _ := state.GuildMentionCount("guild"); count != 1 {
+		t.Errorf("GuildMentionCount is %d, want 1", count)
+	}
+
+	state.OnInterface(s, &MessageAck{MessageID: "12", ChannelID: "channel"})
+
+	if unread, _ := state.GuildUnread("guild"); unread {
+		t.Error("guild is unread after the last message was acked")
+	}
+	if count, err := state.ChannelMentionCount("channel"); err != nil || count != 0 {
+		t.Errorf("ChannelMentionCount is %d, %v, want 0", count, err)
+	}
+
+	state.OnInterface(s, &MessageCreate{&Message{ID: "13", ChannelID: "thread", GuildID: "guild", Author: &User{ID: "other"}, Mentions: []*User{{ID: "me"}}}})
+
+	if unread, _ := state.GuildUnread("guild"); !unread {
+		t.Error("guild is not unread after a new message in a thread")
+	}
+	if count, _ := state.GuildMentionCount("guild"); count != 1 {
+		t.Errorf("GuildMentionCount is %d after a mention in a thread, want 1", count)
+	}
+}
+
+func TestStateReadStatesUnknownChannel(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+	state.MaxMessageCount = 10
+	state.OnInterface(s, &Ready{User: &User{ID: "me"}})
+
+	// The message can't be stored, as its channel isn't in the state.
+	state.OnInterface(s, &MessageCreate{&Message{ID: "1", ChannelID: "channel", Author: &User{ID: "other"}, Mentions: []*User{{ID: "me"}}}})
+
+	if count, err := state.ChannelMentionCount("channel"); err != nil || count != 1 {
+		t.Errorf("ChannelMentionCount is %d, %v, want 1", count, err)
+	}
+	if _, err := state.ChannelMentionCount("other"); err != ErrStateNotFound {
+		t.Errorf("ChannelMentionCount returned %v for an unknown channel, want %v", err, ErrStateNotFound)
+	}
+}
+
+func TestGuildAckReadStates(t *testing.T) {
+	s, closeServer := newTestSession(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path != "/guilds/guild/ack" {
+			t.Errorf("request path is %q, want %q", r.URL.Path, "/guilds/guild/ack")
+		}
+		w.WriteHeader(netHttp.StatusNoContent)
+	})
+	defer closeServer()
+	s.State.OnInterface(s, &Ready{User: &User{ID: "me"}})
+	s.State.OnInterface(s, &GuildCreate{&Guild{
+		ID: "guild",
+		Channels: []*Channel{
+			{ID: "channel", GuildID: "guild"},
+			{ID: "other", GuildID: "guild"},
+		},
+	}})
+	s.State.OnInterface(s, &MessageCreate{&Message{ID: "1", ChannelID: "channel", GuildID: "guild", Author: &User{ID: "other"}, MentionEveryone: true}})
+	s.State.OnInterface(s, &MessageCreate{&Message{ID: "2", ChannelID: "other", GuildID: "guild", Author: &User{ID: "other"}}})
+
+	if unread, _ := s.State.GuildUnread("guild"); !unread {
+		t.Fatal("guild is not unread after new messages")
+	}
+
+	if err := s.GuildAck("guild"); err != nil {
+		t.Fatalf("GuildAck returned error: %v", err)
+	}
+
+	if unread, _ := s.State.GuildUnread("guild"); unread {
+		t.Error("guild is unread after it was acked")
+	}
+	if count, _ := s.State.GuildMentionCount("guild"); count != 0 {
+		t.Errorf("GuildMentionCount is %d, want 0", count)
+	}
+}
+
+func TestReadStatesUnmarshal(t *testing.T) {
+	for _, data := range []string{
+		`[{"id":"channel","last_message_id":"10","mention_count":1}]`,
+		`{"version":1,"partial":false,"entries":[{"id":"channel","last_message_id":"10","mention_count":1}]}`,
+	} {
+		var r ReadStates
+		if err := json.Unmarshal([]byte(data), &r); err != nil {
+			t.Fatalf("Unmarshal returned error: %v", err)
+		}
+		if len(r) != 1 || r[0].ID != "channel" || r[0].MentionCount != 1 {
+			t.Errorf("unexpected read states %+v from %s", r, data)
+		}
+	}
+}
+
+func TestStateUserSettings(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	var r Ready
+	err := json.Unmarshal([]byte(`{
+		"user_settings": {"status": "online", "theme": "dark"},
+		"user_guild_settings": {"version": 1, "partial": false, "entries": [{"guild_id": "guild", "muted": false}]}
+	}`), &r)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	state.OnInterface(s, &r)
+
+	state.OnInterface(s, &UserSettingsUpdate{"status": "dnd"})
+	state.OnInterface(s, &UserGuildSettingsUpdate{&UserGuildSettings{GuildID: "guild", Muted: true}})
+
+	if state.Settings.Status != StatusDoNotDisturb || state.Settings.Theme != "dark" {
+		t.Errorf("settings were not updated: %+v", state.Settings)
+	}
+
+	g, err := state.GuildSettings("guild")
+	if err != nil {
+		t.Fatalf("GuildSettings returned error: %v", err)
+	}
+	if !g.Muted {
+		t.Error("guild settings were not updated")
+	}
+}
+
+func TestStateNotes(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	state.OnInterface(s, &Ready{Notes: map[string]string{"1": "old"}})
+	state.OnInterface(s, &UserNoteUpdate{ID: "1", Note: "new"})
+	state.OnInterface(s, &UserNoteUpdate{ID: "2", Note: "note"})
+	state.OnInterface(s, &UserNoteUpdate{ID: "2", Note: ""})
+
+	if note, err := state.Note("1"); err != nil || note != "new" {
+		t.Errorf("Note returned %q, %v, want %q", note, err, "new")
+	}
+	if _, err := state.Note("2"); err != ErrStateNotFound {
+		t.Errorf("Note returned %v, want %v", err, ErrStateNotFound)
+	}
+}
+
+func TestStateMemberList(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+	state.OnInterface(s, &GuildCreate{&Guild{ID: "guild"}})
+
+	var u GuildMemberListUpdate
+	err := json.Unmarshal([]byte(`{
+		"id": "everyone", "guild_id": "guild", "member_count": 3, "online_count": 2,
+		"groups": [{"id": "online", "count": 2}, {"id": "offline", "count": 1}],
+		"ops": [{"op": "SYNC", "range": [0, 99], "items": [
+			{"group": {"id": "online", "count": 2}},
+			{"member": {"user": {"id": "1"}, "presence": {"status": "online"}}},
+			{"member": {"user": {"id": "2"}, "presence": {"status": "idle"}}},
+			{"group": {"id": "offline", "count": 1}},
+			{"member": {"user": {"id": "3"}}}
+		]}]
+	}`), &u)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	state.OnInterface(s, &u)
+
+	// User 2 goes offline, and user 4 comes online.
+	state.OnInterface(s, &GuildMemberListUpdate{ID: "everyone", GuildID: "guild", Ops: []*MemberListOp{
+		{Op: MemberListOpDelete, Index: 2},
+		{Op: MemberListOpInsert, Index: 3, Item: &MemberListItem{Member: &MemberListMember{Member: &Member{User: &User{ID: "2"}}}}},
+		{Op: MemberListOpInsert, Index: 1, Item: &MemberListItem{Member: &MemberListMember{Member: &Member{User: &User{ID: "4"}}}}},
+	}})
+
+	l, err := state.MemberList("guild", "everyone")
+	if err != nil {
+		t.Fatalf("MemberList returned error: %v", err)
+	}
+
+	var order []string
+	for _, item := range l.Items {
+		switch {
+		case item.Group != nil:
+			order = append(order, item.Group.ID)
+		case item.Member != nil:
+			order = append(order, item.Member.User.ID)
+		}
+	}
+	want := []string{"online", "4", "1", "offline", "2", "3"}
+	if len(order) != len(want) {
+		t.Fatalf("member list is %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("member list is %v, want %v", order, want)
+		}
+	}
+
+	if _, err = state.Member("guild", "3"); err != nil {
+		t.Errorf("Member returned error: %v", err)
+	}
+	if p, err := state.Presence("guild", "1"); err != nil || p.Status != StatusOnline {
+		t.Errorf("Presence returned %+v, %v", p, err)
+	}
+}
+
+func TestStateMemberListMalformedOps(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+	state.OnInterface(s, &GuildCreate{&Guild{ID: "guild"}})
+
+	item := &MemberListItem{Group: &MemberListGroup{ID: "online"}}
+	state.OnInterface(s, &GuildMemberListUpdate{ID: "everyone", GuildID: "guild", Ops: []*MemberListOp{
+		{Op: MemberListOpSync, Range: [2]int{0, 99}, Items: []*MemberListItem{item}},
+		nil,
+		{Op: MemberListOpSync, Range: [2]int{-1, 99}, Items: []*MemberListItem{item}},
+		{Op: MemberListOpInvalidate, Range: [2]int{-5, 0}},
+		{Op: MemberListOpInvalidate, Range: [2]int{1, 0}},
+		{Op: MemberListOpInsert, Index: -1, Item: item},
+		{Op: MemberListOpUpdate, Index: -1, Item: item},
+		{Op: MemberListOpDelete, Index: -1},
+	}})
+
+	l, err := state.MemberList("guild", "everyone")
+	if err != nil {
+		t.Fatalf("MemberList returned error: %v", err)
+	}
+	if len(l.Items) != 1 || l.Items[0] != item {
+		t.Errorf("member list has %d items, want the synced item only", len(l.Items))
+	}
+}
+
+func TestStateStickers(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	state.OnInterface(s, &GuildCreate{&Guild{ID: "1", Stickers: []*Sticker{{ID: "1", Name: "old"}}}})
+	state.OnInterface(s, &GuildStickersUpdate{GuildID: "1", Stickers: []*Sticker{{ID: "1", Name: "new"}, {ID: "2"}}})
+
+	st, err := state.Sticker("1", "1")
+	if err != nil {
+		t.Fatalf("Sticker returned error: %v", err)
+	}
+	if st.Name != "new" {
+		t.Errorf("sticker name is %q, want %q", st.Name, "new")
+	}
+	if _, err = state.Sticker("1", "2"); err != nil {
+		t.Errorf("Sticker returned error: %v", err)
+	}
+
+	state.OnInterface(s, &GuildUpdate{&Guild{ID: "1", Name: "guild"}})
+	if _, err = state.Sticker("1", "2"); err != nil {
+		t.Errorf("Sticker returned error after guild update: %v", err)
+	}
+
+	// Removing a sticker sends the remaining stickers.
+	state.OnInterface(s, &GuildStickersUpdate{GuildID: "1", Stickers: []*Sticker{{ID: "2"}}})
+	if _, err = state.Sticker("1", "1"); err != ErrStateNotFound {
+		t.Errorf("Sticker returned %v for a removed sticker, want %v", err, ErrStateNotFound)
+	}
+	if _, err = state.Sticker("1", "2"); err != nil {
+		t.Errorf("Sticker returned error: %v", err)
+	}
+}
+
+func TestStateStageInstances(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+
+	state.OnInterface(s, &GuildCreate{&Guild{ID: "1"}})
+	state.OnInterface(s, &StageInstanceCreate{&StageInstance{ID: "1", GuildID: "1", ChannelID: "2", Topic: "old"}})
+	state.OnInterface(s, &StageInstanceUpdate{&StageInstance{ID: "1", GuildID: "1", ChannelID: "2", Topic: "new"}})
+
+	st, err := state.StageInstance("1", "2")
+	if err != nil {
+		t.Fatalf("StageInstance returned error: %v", err)
+	}
+	if st.Topic != "new" {
+		t.Errorf("stage topic is %q, want %q", st.Topic, "new")
+	}
+
+	state.OnInterface(s, &StageInstanceDelete{&StageInstance{ID: "1", GuildID: "1", ChannelID: "2"}})
+	if _, err = state.StageInstance("1", "2"); err != ErrStateNotFound {
+		t.Errorf("StageInstance returned %v, want %v", err, ErrStateNotFound)
+	}
+}
@@ -0,0 +1,356 @@
+// This file contains the StateStore interface, which holds the data tracked by
+// a State, and its default in-memory implementation.
+
+package astatine
+
+// A StateStore stores the guilds, channels, members, presences and messages
+// tracked by a State.
+//
+// State applies a change by getting a value from the store, updating it and
+// adding it back, so a store may return either the value it holds or a copy
+// of it. State calls its store with its lock held: methods which change the
+// store are called with the write lock, others with the read lock, so only
+// concurrent reads need to be safe.
+type StateStore interface {
+	// Guild returns a guild by ID, or ErrStateNotFound.
+	Guild(guildID string) (*Guild, error)
+
+	// GuildAdd adds a guild, or replaces it if it already exists.
+	// When the Members or Presences of the guild are not nil, they
+	// replace the members or presences stored for the guild.
+	GuildAdd(guild *Guild) error
+
+	// GuildRemove removes a guild by ID.
+	GuildRemove(guildID string) error
+
+	// Guilds returns every guild.
+	Guilds() ([]*Guild, error)
+
+	// Channel returns a channel by ID, or ErrStateNotFound.
+	Channel(channelID string) (*Channel, error)
+
+	// ChannelAdd adds a channel, or replaces it if it already exists.
+	ChannelAdd(channel *Channel) error
+
+	// ChannelRemove removes a channel by ID.
+	ChannelRemove(channelID string) error
+
+	// PrivateChannels returns every DM and group DM channel.
+	PrivateChannels() ([]*Channel, error)
+
+	// Member returns a member of a guild, or ErrStateNotFound.
+	Member(guildID, userID string) (*Member, error)
+
+	// MemberAdd adds a member to its guild, or replaces it if it already
+	// exists. ErrStateNotFound is returned if the guild doesn't exist.
+	MemberAdd(member *Member) error
+
+	// MemberRemove removes a member from a guild.
+	MemberRemove(guildID, userID string) error
+
+	// Presence returns the presence of a user in a guild, or
+	// ErrStateNotFound.
+	Presence(guildID, userID string) (*Presence, error)
+
+	// PresenceAdd adds a presence to a guild, or replaces it if it already
+	// exists. ErrStateNotFound is returned if the guild doesn't exist.
+	PresenceAdd(guildID string, presence *Presence) error
+
+	// PresenceRemove removes the presence of a user from a guild.
+	PresenceRemove(guildID, userID string) error
+
+	// Messages returns the messages of a channel, oldest first, or
+	// ErrStateNotFound if the channel doesn't exist.
+	Messages(channelID string) ([]*Message, error)
+
+	// MessageAdd adds a message to its channel, or replaces it if it
+	// already exists. Once a channel has more than limit messages, the
+	// oldest are removed. ErrStateNotFound is returned if the channel
+	// doesn't exist.
+	MessageAdd(message *Message, limit int) error
+
+	// MessageRemove removes a message from a channel.
+	MessageRemove(channelID, messageID string) error
+}
+
+// memoryStateStore is the default StateStore, which keeps everything in
+// memory. It also maintains the Members and Presences of every guild and the
+// Messages of every channel.
+type memoryStateStore struct {
+	guilds          []*Guild
+	privateChannels []*Channel
+
+	guildMap   map[string]*Guild
+	channelMap map[string]*Channel
+	memberMap  map[string]map[string]*Member
+}
+
+func newMemoryStateStore() *memoryStateStore {
+	return &memoryStateStore{
+		guilds:          []*Guild{},
+		privateChannels: []*Channel{},
+		guildMap:        make(map[string]*Guild),
+		channelMap:      make(map[string]*Channel),
+		memberMap:       make(map[string]map[string]*Member),
+	}
+}
+
+func (m *memoryStateStore) createMemberMap(guild *Guild) {
+	members := make(map[string]*Member)
+	for _, member := range guild.Members {
+		members[member.User.ID] = member
+	}
+	m.memberMap[guild.ID] = members
+}
+
+func (m *memoryStateStore) Guild(guildID string) (*Guild, error) {
+	if g, ok := m.guildMap[guildID]; ok {
+		return g, nil
+	}
+
+	return nil, ErrStateNotFound
+}
+
+func (m *memoryStateStore) GuildAdd(guild *Guild) error {
+	g, ok := m.guildMap[guild.ID]
+	if ok && g == guild {
+		return nil
+	}
+
+	// If this guild contains a new member slice, we must regenerate the member map so the pointers stay valid
+	if guild.Members != nil {
+		m.createMemberMap(guild)
+	} else if _, ok := m.memberMap[guild.ID]; !ok {
+		// Even if we have no new member slice, we still initialize the member map for this guild if it doesn't exist
+		m.memberMap[guild.ID] = make(map[string]*Member)
+	}
+
+	if ok {
+		// Replace the guild in place, so that it is updated in the
+		// guild list too.
+		*g = *guild
+		return nil
+	}
+
+	m.guilds = append(m.guilds, guild)
+	m.guildMap[guild.ID] = guild
+
+	return nil
+}
+
+func (m *memoryStateStore) GuildRemove(guildID string) error {
+	if _, ok := m.guildMap[guildID]; !ok {
+		return ErrStateNotFound
+	}
+
+	delete(m.guildMap, guildID)
+
+	for i, g := range m.guilds {
+		if g.ID == guildID {
+			m.guilds = append(m.guilds[:i], m.guilds[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStateStore) Guilds() ([]*Guild, error) {
+	return m.guilds, nil
+}
+
+func (m *memoryStateStore) Channel(channelID string) (*Channel, error) {
+	if c, ok := m.channelMap[channelID]; ok {
+		return c, nil
+	}
+
+	return nil, ErrStateNotFound
+}
+
+func (m *memoryStateStore) ChannelAdd(channel *Channel) error {
+	_, ok := m.channelMap[channel.ID]
+	if !ok && (channel.Type == ChannelTypeDM || channel.Type == ChannelTypeGroupDM) {
+		m.privateChannels = append(m.privateChannels, channel)
+	}
+
+	m.channelMap[channel.ID] = channel
+	return nil
+}
+
+func (m *memoryStateStore) ChannelRemove(channelID string) error {
+	channel, ok := m.channelMap[channelID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	if channel.Type == ChannelTypeDM || channel.Type == ChannelTypeGroupDM {
+		for i, c := range m.privateChannels {
+			if c.ID == channelID {
+				m.privateChannels = append(m.privateChannels[:i], m.privateChannels[i+1:]...)
+				break
+			}
+		}
+	}
+
+	delete(m.channelMap, channelID)
+	return nil
+}
+
+func (m *memoryStateStore) PrivateChannels() ([]*Channel, error) {
+	return m.privateChannels, nil
+}
+
+func (m *memoryStateStore) Member(guildID, userID string) (*Member, error) {
+	members, ok := m.memberMap[guildID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	if member, ok := members[userID]; ok {
+		return member, nil
+	}
+
+	return nil, ErrStateNotFound
+}
+
+func (m *memoryStateStore) MemberAdd(member *Member) error {
+	guild, ok := m.guildMap[member.GuildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	members, ok := m.memberMap[member.GuildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	if old, ok := members[member.User.ID]; ok {
+		if old != member {
+			*old = *member
+		}
+		return nil
+	}
+
+	members[member.User.ID] = member
+	guild.Members = append(guild.Members, member)
+	return nil
+}
+
+func (m *memoryStateStore) MemberRemove(guildID, userID string) error {
+	guild, ok := m.guildMap[guildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	members, ok := m.memberMap[guildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	if _, ok = members[userID]; !ok {
+		return ErrStateNotFound
+	}
+	delete(members, userID)
+
+	for i, member := range guild.Members {
+		if member.User.ID == userID {
+			guild.Members = append(guild.Members[:i], guild.Members[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrStateNotFound
+}
+
+func (m *memoryStateStore) Presence(guildID, userID string) (*Presence, error) {
+	guild, ok := m.guildMap[guildID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	for _, p := range guild.Presences {
+		if p.User.ID == userID {
+			return p, nil
+		}
+	}
+
+	return nil, ErrStateNotFound
+}
+
+func (m *memoryStateStore) PresenceAdd(guildID string, presence *Presence) error {
+	guild, ok := m.guildMap[guildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	for i, p := range guild.Presences {
+		if p.User.ID == presence.User.ID {
+			guild.Presences[i] = presence
+			return nil
+		}
+	}
+
+	guild.Presences = append(guild.Presences, presence)
+	return nil
+}
+
+func (m *memoryStateStore) PresenceRemove(guildID, userID string) error {
+	guild, ok := m.guildMap[guildID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	for i, p := range guild.Presences {
+		if p.User.ID == userID {
+			guild.Presences = append(guild.Presences[:i], guild.Presences[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrStateNotFound
+}
+
+func (m *memoryStateStore) Messages(channelID string) ([]*Message, error) {
+	channel, ok := m.channelMap[channelID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return channel.Messages, nil
+}
+
+func (m *memoryStateStore) MessageAdd(message *Message, limit int) error {
+	channel, ok := m.channelMap[message.ChannelID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	for i, msg := range channel.Messages {
+		if msg.ID == message.ID {
+			channel.Messages[i] = message
+			return nil
+		}
+	}
+
+	channel.Messages = append(channel.Messages, message)
+	if len(channel.Messages) > limit {
+		channel.Messages = channel.Messages[len(channel.Messages)-limit:]
+	}
+	return nil
+}
+
+func (m *memoryStateStore) MessageRemove(channelID, messageID string) error {
+	channel, ok := m.channelMap[channelID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	for i, msg := range channel.Messages {
+		if msg.ID == messageID {
+			channel.Messages = append(channel.Messages[:i], channel.Messages[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrStateNotFound
+}
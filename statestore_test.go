@@ -0,0 +1,266 @@
+package astatine
+
+import (
+	"sort"
+	"testing"
+)
+
+// copyStateStore is a StateStore which stores copies of guilds and channels,
+// like a store backed by a cache or a database would.
+type copyStateStore struct {
+	guilds    map[string]Guild
+	channels  map[string]Channel
+	members   map[string]Member
+	presences map[string]Presence
+}
+
+func newCopyStateStore() *copyStateStore {
+	return &copyStateStore{
+		guilds:    make(map[string]Guild),
+		channels:  make(map[string]Channel),
+		members:   make(map[string]Member),
+		presences: make(map[string]Presence),
+	}
+}
+
+func (c *copyStateStore) Guild(guildID string) (*Guild, error) {
+	g, ok := c.guilds[guildID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	return &g, nil
+}
+
+func (c *copyStateStore) GuildAdd(guild *Guild) error {
+	g := *guild
+	for _, m := range g.Members {
+		c.members[g.ID+m.User.ID] = *m
+	}
+	for _, p := range g.Presences {
+		c.presences[g.ID+p.User.ID] = *p
+	}
+	g.Members, g.Presences = nil, nil
+	c.guilds[g.ID] = g
+	return nil
+}
+
+func (c *copyStateStore) GuildRemove(guildID string) error {
+	delete(c.guilds, guildID)
+	return nil
+}
+
+func (c *copyStateStore) Guilds() ([]*Guild, error) {
+	guilds := make([]*Guild, 0, len(c.guilds))
+	for _, g := range c.guilds {
+		g := g
+		guilds = append(guilds, &g)
+	}
+	sort.Slice(guilds, func(i, j int) bool { return guilds[i].ID < guilds[j].ID })
+	return guilds, nil
+}
+
+func (c *copyStateStore) Channel(channelID string) (*Channel, error) {
+	ch, ok := c.channels[channelID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	return &ch, nil
+}
+
+func (c *copyStateStore) ChannelAdd(channel *Channel) error {
+	c.channels[channel.ID] = *channel
+	return nil
+}
+
+func (c *copyStateStore) ChannelRemove(channelID string) error {
+	delete(c.channels, channelID)
+	return nil
+}
+
+func (c *copyStateStore) PrivateChannels() ([]*Channel, error) {
+	channels := []*Channel{}
+	for _, ch := range c.channels {
+		if ch.Type == ChannelTypeDM || ch.Type == ChannelTypeGroupDM {
+			ch := ch
+			channels = append(channels, &ch)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].ID < channels[j].ID })
+	return channels, nil
+}
+
+func (c *copyStateStore) Member(guildID, userID string) (*Member, error) {
+	m, ok := c.members[guildID+userID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	return &m, nil
+}
+
+func (c *copyStateStore) MemberAdd(member *Member) error {
+	if _, ok := c.guilds[member.GuildID]; !ok {
+		return ErrStateNotFound
+	}
+	c.members[member.GuildID+member.User.ID] = *member
+	return nil
+}
+
+func (c *copyStateStore) MemberRemove(guildID, userID string) error {
+	delete(c.members, guildID+userID)
+	return nil
+}
+
+func (c *copyStateStore) Presence(guildID, userID string) (*Presence, error) {
+	p, ok := c.presences[guildID+userID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	return &p, nil
+}
+
+func (c *copyStateStore) PresenceAdd(guildID string, presence *Presence) error {
+	if _, ok := c.guilds[guildID]; !ok {
+		return ErrStateNotFound
+	}
+	c.presences[guildID+presence.User.ID] = *presence
+	return nil
+}
+
+func (c *copyStateStore) PresenceRemove(guildID, userID string) error {
+	delete(c.presences, guildID+userID)
+	return nil
+}
+
+func (c *copyStateStore) Messages(channelID string) ([]*Message, error) {
+	ch, ok := c.channels[channelID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	messages := make([]*Message, len(ch.Messages))
+	for i, m := range ch.Messages {
+		msg := *m
+		messages[i] = &msg
+	}
+	return messages, nil
+}
+
+func (c *copyStateStore) MessageAdd(message *Message, limit int) error {
+	ch, ok := c.channels[message.ChannelID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	msg := *message
+	messages := append([]*Message{}, ch.Messages...)
+	for i, m := range messages {
+		if m.ID == message.ID {
+			messages[i] = &msg
+			ch.Messages = messages
+			c.channels[ch.ID] = ch
+			return nil
+		}
+	}
+
+	messages = append(messages, &msg)
+	if len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+
+	ch.Messages = messages
+	c.channels[ch.ID] = ch
+	return nil
+}
+
+func (c *copyStateStore) MessageRemove(channelID, messageID string) error {
+	ch, ok := c.channels[channelID]
+	if !ok {
+		return ErrStateNotFound
+	}
+
+	messages := []*Message{}
+	for _, m := range ch.Messages {
+		if m.ID != messageID {
+			messages = append(messages, m)
+		}
+	}
+
+	ch.Messages = messages
+	c.channels[ch.ID] = ch
+	return nil
+}
+
+func TestStateStore(t *testing.T) {
+	s := &Session{StateEnabled: true}
+	state := NewState()
+	state.MaxMessageCount = 10
+	state.Store = newCopyStateStore()
+
+	state.OnInterface(s, &GuildCreate{&Guild{
+		ID:       "guild",
+		Channels: []*Channel{{ID: "channel", GuildID: "guild", Name: "general"}},
+		Members:  []*Member{{GuildID: "guild", User: &User{ID: "user"}}},
+	}})
+	state.OnInterface(s, &GuildMemberAdd{&Member{GuildID: "guild", User: &User{ID: "other"}}})
+	state.OnInterface(s, &GuildRoleCreate{&GuildRole{GuildID: "guild", Role: &Role{ID: "role"}}})
+	state.OnInterface(s, &ChannelUpdate{&Channel{ID: "channel", GuildID: "guild", Name: "chat"}})
+	state.OnInterface(s, &MessageCreate{&Message{ID: "message", ChannelID: "channel"}})
+
+	guild, err := state.Guild("guild")
+	if err != nil {
+		t.Fatalf("Guild returned error: %v", err)
+	}
+	if len(state.Guilds) != 1 || state.Guilds[0].ID != "guild" {
+		t.Errorf("State has %d guilds, want guild", len(state.Guilds))
+	}
+	if guild.MemberCount != 1 {
+		t.Errorf("MemberCount is %d, want 1", guild.MemberCount)
+	}
+	if len(guild.Channels) != 1 || guild.Channels[0].Name != "chat" {
+		t.Errorf("guild channels were not updated: %+v", guild.Channels)
+	}
+
+	for _, userID := range []string{"user", "other"} {
+		if _, err = state.Member("guild", userID); err != nil {
+			t.Errorf("Member(%q) returned error: %v", userID, err)
+		}
+	}
+	if _, err = state.Role("guild", "role"); err != nil {
+		t.Errorf("Role returned error: %v", err)
+	}
+	if _, err = state.Message("channel", "message"); err != nil {
+		t.Errorf("Message returned error: %v", err)
+	}
+
+	state.OnInterface(s, &MessageUpdate{Message: &Message{ID: "message", ChannelID: "channel", Content: "edited"}})
+	if m, err := state.Message("channel", "message"); err != nil || m.Content != "edited" {
+		t.Errorf("Message returned %+v, %v, want edited message", m, err)
+	}
+
+	state.OnInterface(s, &MessageDelete{Message: &Message{ID: "message", ChannelID: "channel"}})
+	if _, err = state.Message("channel", "message"); err != ErrStateNotFound {
+		t.Errorf("Message returned %v after delete, want %v", err, ErrStateNotFound)
+	}
+}
+
+func TestStateStoreMessageLimit(t *testing.T) {
+	state := NewState()
+	state.MaxMessageCount = 2
+	if err := state.ChannelAdd(&Channel{ID: "channel", Type: ChannelTypeDM}); err != nil {
+		t.Fatalf("ChannelAdd returned error: %v", err)
+	}
+
+	for _, id := range []string{"1", "2", "3"} {
+		if err := state.MessageAdd(&Message{ID: id, ChannelID: "channel"}); err != nil {
+			t.Fatalf("MessageAdd returned error: %v", err)
+		}
+	}
+
+	messages, err := state.Store.Messages("channel")
+	if err != nil {
+		t.Fatalf("Messages returned error: %v", err)
+	}
+	if len(messages) != 2 || messages[0].ID != "2" || messages[1].ID != "3" {
+		t.Errorf("Messages returned %d messages, want messages 2 and 3", len(messages))
+	}
+}
@@ -48,6 +48,15 @@ type Session struct {
 	// Should the session request compressed websocket data.
 	Compress bool
 
+	// Should the session request zlib-stream transport compression for the
+	// gateway connection. When enabled, payload compression requested
+	// through Identify.Compress is not used.
+	TransportCompression bool
+
+	// The codec used to encode and decode gateway payloads.
+	// Defaults to GatewayCodecJSON when nil.
+	GatewayCodec GatewayCodec
+
 	// Sharding
 	ShardID    int
 	ShardCount int
@@ -69,6 +78,11 @@ type Session struct {
 	// Max number of REST API retries
 	MaxRestRetries int
 
+	// Decides which failed REST requests are retried, DefaultRetryPolicy
+	// when it is the zero value. To retry no requests but rate limited
+	// ones, set its StatusCodes to an empty slice.
+	RetryPolicy RetryPolicy
+
 	// Status stores the currect status of the websocket connection
 	// this is being tested, may stay, may go away.
 	status int32
@@ -92,14 +106,22 @@ type Session struct {
 	// The user agent used for REST APIs
 	UserAgent string
 
+	// The base URLs of REST and CDN requests. When set, they replace
+	// http.EndpointAPI and http.EndpointCDN in the URL of every request,
+	// e.g. to send requests through a proxy or to a local test server.
+	APIBase string
+	CDNBase string
+
 	// Stores the last HeartbeatAck that was received (in UTC)
 	LastHeartbeatAck time.Time
 
 	// Stores the last Heartbeat sent (in UTC)
 	LastHeartbeatSent time.Time
 
-	// used to deal with rate limits
-	Ratelimiter *http.RateLimiter
+	// used to deal with rate limits, an in-process http.RateLimiter by
+	// default. Use an http.RemoteRateLimiter to share rate limits between
+	// processes, or http.NopLimiter when a ratelimiting proxy handles them.
+	Ratelimiter http.Limiter
 
 	// Event handlers
 	handlersMu   sync.RWMutex
@@ -118,9 +140,16 @@ type Session struct {
 	// stores sessions current Discord Gateway
 	gateway string
 
+	// inflates the messages of the current gateway connection when
+	// TransportCompression is enabled
+	zlibStream *zlibStream
+
 	// stores session ID of current Gateway connection
 	sessionID string
 
+	// stores the gateway to use when resuming the current session
+	resumeGateway string
+
 	// used to make sure gateway websocket writes do not happen concurrently
 	wsMutex sync.Mutex
 }
@@ -257,8 +286,69 @@ const (
 	ChannelTypeGuildNewsThread    ChannelType = 10
 	ChannelTypeGuildPublicThread  ChannelType = 11
 	ChannelTypeGuildPrivateThread ChannelType = 12
+	ChannelTypeGuildStageVoice    ChannelType = 13
+	ChannelTypeGuildDirectory     ChannelType = 14
+	ChannelTypeGuildForum         ChannelType = 15
+	ChannelTypeGuildMedia         ChannelType = 16
+)
+
+// ChannelFlags represent flags of a channel or thread.
+type ChannelFlags int
+
+// Block containing known ChannelFlags values.
+const (
+	// ChannelFlagPinned indicates whether the thread is pinned in the forum channel.
+	ChannelFlagPinned ChannelFlags = 1 << 1
+	// ChannelFlagRequireTag indicates whether a tag is required to be specified when creating a thread in a forum channel.
+	ChannelFlagRequireTag ChannelFlags = 1 << 4
+)
+
+// ForumSortOrderType represents the sort order of posts in a forum channel.
+type ForumSortOrderType int
+
+// Block containing known ForumSortOrderType values.
+const (
+	// ForumSortOrderLatestActivity sorts posts by activity.
+	ForumSortOrderLatestActivity ForumSortOrderType = 0
+	// ForumSortOrderCreationDate sorts posts by creation time (from most recent to oldest).
+	ForumSortOrderCreationDate ForumSortOrderType = 1
+)
+
+// ForumLayout represents the layout of posts in a forum channel.
+type ForumLayout int
+
+// Block containing known ForumLayout values.
+const (
+	// ForumLayoutNotSet represents no default layout.
+	ForumLayoutNotSet ForumLayout = 0
+	// ForumLayoutListView displays forum posts as a list.
+	ForumLayoutListView ForumLayout = 1
+	// ForumLayoutGalleryView displays forum posts as a collection of tiles.
+	ForumLayoutGalleryView ForumLayout = 2
 )
 
+// ForumTag represents a tag which can be applied to the posts of a forum channel.
+type ForumTag struct {
+	// The ID of the tag, empty when creating a tag.
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+
+	// Whether only members with the ManageThreads permission can apply the tag.
+	Moderated bool `json:"moderated"`
+
+	// The ID of a custom emoji, or the unicode character of an emoji, shown with the tag.
+	EmojiID   string `json:"emoji_id,omitempty"`
+	EmojiName string `json:"emoji_name,omitempty"`
+}
+
+// ForumDefaultReaction specifies the emoji which is shown on the add
+// reaction button of the posts of a forum channel.
+type ForumDefaultReaction struct {
+	// The ID of a custom emoji, or the unicode character of an emoji.
+	EmojiID   string `json:"emoji_id,omitempty"`
+	EmojiName string `json:"emoji_name,omitempty"`
+}
+
 // A Channel holds all data related to an individual Discord channel.
 type Channel struct {
 	// The ID of the channel.
@@ -335,6 +425,30 @@ type Channel struct {
 
 	// All thread members. State channels only.
 	Members []*ThreadMember `json:"-"`
+
+	// Channel flags.
+	Flags ChannelFlags `json:"flags"`
+
+	// The set of tags that can be used in a forum channel.
+	AvailableTags []ForumTag `json:"available_tags"`
+
+	// The IDs of the set of tags that have been applied to a thread in a forum channel.
+	AppliedTags []string `json:"applied_tags"`
+
+	// Emoji to use as the default reaction to a forum post.
+	DefaultReactionEmoji ForumDefaultReaction `json:"default_reaction_emoji"`
+
+	// The initial RateLimitPerUser to set on newly created threads in a channel.
+	// This field is copied to the thread at creation time and does not live update.
+	DefaultThreadRateLimitPerUser int `json:"default_thread_rate_limit_per_user"`
+
+	// The default sort order type used to order posts in forum channels.
+	// Defaults to null, which indicates a preferred sort order hasn't been set by a channel admin.
+	DefaultSortOrder *ForumSortOrderType `json:"default_sort_order"`
+
+	// The default forum layout view used to display posts in forum channels.
+	// Defaults to ForumLayoutNotSet, which indicates a layout view has not been set by a channel admin.
+	DefaultForumLayout ForumLayout `json:"default_forum_layout"`
 }
 
 // Mention returns a string which mentions the channel
@@ -365,6 +479,16 @@ type ChannelEdit struct {
 	AutoArchiveDuration int  `json:"auto_archive_duration,omitempty"`
 	Locked              bool `json:"locked,bool"`
 	Invitable           bool `json:"invitable,omitempty"`
+
+	// NOTE: forum channels and their threads only
+
+	Flags                         *ChannelFlags         `json:"flags,omitempty"`
+	AvailableTags                 *[]ForumTag           `json:"available_tags,omitempty"`
+	AppliedTags                   *[]string             `json:"applied_tags,omitempty"`
+	DefaultReactionEmoji          *ForumDefaultReaction `json:"default_reaction_emoji,omitempty"`
+	DefaultThreadRateLimitPerUser int                   `json:"default_thread_rate_limit_per_user,omitempty"`
+	DefaultSortOrder              *ForumSortOrderType   `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            *ForumLayout          `json:"default_forum_layout,omitempty"`
 }
 
 // A ChannelFollow holds data returned after following a news channel
@@ -398,6 +522,9 @@ type ThreadStart struct {
 	Type                ChannelType `json:"type,omitempty"`
 	Invitable           bool        `json:"invitable"`
 	RateLimitPerUser    int         `json:"rate_limit_per_user,omitempty"`
+
+	// NOTE: forum threads only
+	AppliedTags []string `json:"applied_tags,omitempty"`
 }
 
 // ThreadMetadata contains a number of thread-specific channel fields that are not needed by other channel types.
@@ -527,6 +654,17 @@ type StickerPack struct {
 	BannerAssetID  string     `json:"banner_asset_id"`
 }
 
+// StickerParams stores the parameters of a guild sticker for
+// GuildStickerCreate and GuildStickerEdit.
+type StickerParams struct {
+	// The name of the sticker (2-30 characters)
+	Name string `json:"name,omitempty"`
+	// The description of the sticker (empty or 2-100 characters)
+	Description string `json:"description,omitempty"`
+	// The name of a unicode emoji which suggests the sticker, or the ID of a custom emoji
+	Tags string `json:"tags,omitempty"`
+}
+
 // VerificationLevel type definition
 type VerificationLevel int
 
@@ -664,6 +802,11 @@ type Guild struct {
 	// update events, and thus is only present in state-cached guilds.
 	VoiceStates []*VoiceState `json:"voice_states"`
 
+	// A list of the live stage instances in the guild.
+	// This field is only present in GUILD_CREATE events and websocket
+	// update events, and thus is only present in state-cached guilds.
+	StageInstances []*StageInstance `json:"stage_instances"`
+
 	// Whether this guild is currently unavailable (most likely due to outage).
 	// This field is only present in GUILD_CREATE events and websocket
 	// update events, and thus is only present in state-cached guilds.
@@ -907,6 +1050,186 @@ type GuildScheduledEventUser struct {
 	Member                *Member `json:"member"`
 }
 
+// StageInstance holds information about a live stage.
+// https://discord.com/developers/docs/resources/stage-instance#stage-instance-object
+type StageInstance struct {
+	// The ID of the stage instance
+	ID string `json:"id"`
+	// The guild id of the associated stage channel
+	GuildID string `json:"guild_id"`
+	// The id of the associated stage channel
+	ChannelID string `json:"channel_id"`
+	// The topic of the stage instance (1-120 characters)
+	Topic string `json:"topic"`
+	// The privacy level of the stage instance
+	PrivacyLevel StageInstancePrivacyLevel `json:"privacy_level"`
+	// The id of the scheduled event for this stage instance
+	GuildScheduledEventID string `json:"guild_scheduled_event_id"`
+}
+
+// StageInstanceParams stores the parameters of a stage instance for
+// StageInstanceCreate and StageInstanceEdit.
+type StageInstanceParams struct {
+	// The id of the stage channel, only used when creating a stage instance
+	ChannelID string `json:"channel_id,omitempty"`
+	// The topic of the stage instance (1-120 characters)
+	Topic string `json:"topic,omitempty"`
+	// The privacy level of the stage instance
+	PrivacyLevel StageInstancePrivacyLevel `json:"privacy_level,omitempty"`
+	// Whether to notify @everyone that the stage instance has started
+	SendStartNotification bool `json:"send_start_notification,omitempty"`
+}
+
+// StageInstancePrivacyLevel is the privacy level of a stage instance.
+// https://discord.com/developers/docs/resources/stage-instance#stage-instance-object-privacy-level
+type StageInstancePrivacyLevel int
+
+const (
+	// StageInstancePrivacyLevelPublic makes the stage instance visible
+	// publicly, deprecated by Discord
+	StageInstancePrivacyLevelPublic StageInstancePrivacyLevel = 1
+	// StageInstancePrivacyLevelGuildOnly makes the stage instance only
+	// visible to guild members
+	StageInstancePrivacyLevelGuildOnly StageInstancePrivacyLevel = 2
+)
+
+// AutoModerationRule stores the configuration of an auto moderation rule.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object
+type AutoModerationRule struct {
+	// The ID of the rule
+	ID string `json:"id"`
+	// The guild id which the rule belongs to
+	GuildID string `json:"guild_id"`
+	// The name of the rule
+	Name string `json:"name"`
+	// The id of the user who created the rule
+	CreatorID string `json:"creator_id"`
+	// The event which triggers the rule
+	EventType AutoModerationRuleEventType `json:"event_type"`
+	// The type of content which triggers the rule
+	TriggerType AutoModerationRuleTriggerType `json:"trigger_type"`
+	// Additional data which decides whether the rule is triggered
+	TriggerMetadata *AutoModerationTriggerMetadata `json:"trigger_metadata"`
+	// The actions executed when the rule is triggered
+	Actions []*AutoModerationAction `json:"actions"`
+	// Whether the rule is enabled
+	Enabled bool `json:"enabled"`
+	// The ids of the roles which are not affected by the rule (maximum of 20)
+	ExemptRoles []string `json:"exempt_roles"`
+	// The ids of the channels which are not affected by the rule (maximum of 50)
+	ExemptChannels []string `json:"exempt_channels"`
+}
+
+// AutoModerationRuleParams stores the parameters of an auto moderation rule
+// for AutoModerationRuleCreate and AutoModerationRuleEdit.
+type AutoModerationRuleParams struct {
+	// The name of the rule
+	Name string `json:"name,omitempty"`
+	// The event which triggers the rule
+	EventType AutoModerationRuleEventType `json:"event_type,omitempty"`
+	// The type of content which triggers the rule, can only be set when creating a rule
+	TriggerType AutoModerationRuleTriggerType `json:"trigger_type,omitempty"`
+	// Additional data which decides whether the rule is triggered
+	TriggerMetadata *AutoModerationTriggerMetadata `json:"trigger_metadata,omitempty"`
+	// The actions executed when the rule is triggered
+	Actions []*AutoModerationAction `json:"actions,omitempty"`
+	// Whether the rule is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+	// The ids of the roles which are not affected by the rule (maximum of 20)
+	ExemptRoles *[]string `json:"exempt_roles,omitempty"`
+	// The ids of the channels which are not affected by the rule (maximum of 50)
+	ExemptChannels *[]string `json:"exempt_channels,omitempty"`
+}
+
+// AutoModerationRuleEventType is the event which triggers an auto moderation rule.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-event-types
+type AutoModerationRuleEventType int
+
+const (
+	// AutoModerationEventMessageSend triggers the rule when a member sends or edits a message
+	AutoModerationEventMessageSend AutoModerationRuleEventType = 1
+)
+
+// AutoModerationRuleTriggerType is the type of content which triggers an auto moderation rule.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-trigger-types
+type AutoModerationRuleTriggerType int
+
+const (
+	// AutoModerationTriggerKeyword triggers the rule when content contains words of a user defined list
+	AutoModerationTriggerKeyword AutoModerationRuleTriggerType = 1
+	// AutoModerationTriggerSpam triggers the rule when content is generic spam
+	AutoModerationTriggerSpam AutoModerationRuleTriggerType = 3
+	// AutoModerationTriggerKeywordPreset triggers the rule when content contains words of internal lists
+	AutoModerationTriggerKeywordPreset AutoModerationRuleTriggerType = 4
+	// AutoModerationTriggerMentionSpam triggers the rule when content contains too many mentions
+	AutoModerationTriggerMentionSpam AutoModerationRuleTriggerType = 5
+)
+
+// AutoModerationTriggerMetadata stores the additional data which decides
+// whether an auto moderation rule is triggered, depending on its trigger type.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-trigger-metadata
+type AutoModerationTriggerMetadata struct {
+	// Substrings which trigger the rule (maximum of 1000), for keyword rules
+	KeywordFilter []string `json:"keyword_filter,omitempty"`
+	// Regular expressions which trigger the rule (maximum of 10), for keyword rules
+	RegexPatterns []string `json:"regex_patterns,omitempty"`
+	// The internal word lists which trigger the rule, for keyword preset rules
+	Presets []AutoModerationKeywordPreset `json:"presets,omitempty"`
+	// Substrings which do not trigger the rule, for keyword and keyword preset rules
+	AllowList *[]string `json:"allow_list,omitempty"`
+	// The number of unique role and user mentions allowed per message (maximum of 50), for mention spam rules
+	MentionTotalLimit int `json:"mention_total_limit,omitempty"`
+	// Whether to detect mention raids, for mention spam rules
+	MentionRaidProtectionEnabled bool `json:"mention_raid_protection_enabled,omitempty"`
+}
+
+// AutoModerationKeywordPreset is an internal word list of Discord.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-keyword-preset-types
+type AutoModerationKeywordPreset int
+
+const (
+	// AutoModerationKeywordPresetProfanity contains words which may be considered swearing or cursing
+	AutoModerationKeywordPresetProfanity AutoModerationKeywordPreset = 1
+	// AutoModerationKeywordPresetSexualContent contains words which refer to sexually explicit behavior or activity
+	AutoModerationKeywordPresetSexualContent AutoModerationKeywordPreset = 2
+	// AutoModerationKeywordPresetSlurs contains personal insults or words which may be considered hate speech
+	AutoModerationKeywordPresetSlurs AutoModerationKeywordPreset = 3
+)
+
+// AutoModerationAction is an action executed when an auto moderation rule is triggered.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object
+type AutoModerationAction struct {
+	// The type of the action
+	Type AutoModerationActionType `json:"type"`
+	// Additional data of the action, depending on its type
+	Metadata *AutoModerationActionMetadata `json:"metadata,omitempty"`
+}
+
+// AutoModerationActionType is the type of an auto moderation action.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object-action-types
+type AutoModerationActionType int
+
+const (
+	// AutoModerationActionBlockMessage blocks the content of a message
+	AutoModerationActionBlockMessage AutoModerationActionType = 1
+	// AutoModerationActionSendAlertMessage logs the content to a channel
+	AutoModerationActionSendAlertMessage AutoModerationActionType = 2
+	// AutoModerationActionTimeout times out the member
+	AutoModerationActionTimeout AutoModerationActionType = 3
+)
+
+// AutoModerationActionMetadata stores the additional data of an auto moderation action.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object-action-metadata
+type AutoModerationActionMetadata struct {
+	// The channel to which the content is logged, for send alert message actions
+	ChannelID string `json:"channel_id,omitempty"`
+	// The duration of the timeout in seconds (maximum of 2419200), for timeout actions
+	Duration int `json:"duration_seconds,omitempty"`
+	// The message shown to the member whose message was blocked (maximum of 150 characters),
+	// for block message actions
+	CustomMessage string `json:"custom_message,omitempty"`
+}
+
 // A GuildTemplate represents
 type GuildTemplate struct {
 	// The unique code for the guild template
@@ -1068,6 +1391,10 @@ type VoiceState struct {
 	SelfDeaf  bool   `json:"self_deaf"`
 	Mute      bool   `json:"mute"`
 	Deaf      bool   `json:"deaf"`
+
+	// The time at which the user requested to speak in a stage channel,
+	// nil if they did not.
+	RequestToSpeakTimestamp *time.Time `json:"request_to_speak_timestamp"`
 }
 
 // A Presence stores the online, offline, or idle and game status of Guild members.
@@ -1186,6 +1513,26 @@ type Settings struct {
 	DeveloperMode          bool               `json:"developer_mode"`
 }
 
+// SettingsEdit holds the user settings to change with UserSettingsEdit.
+// Nil fields are left unchanged.
+type SettingsEdit struct {
+	RenderEmbeds           *bool              `json:"render_embeds,omitempty"`
+	InlineEmbedMedia       *bool              `json:"inline_embed_media,omitempty"`
+	InlineAttachmentMedia  *bool              `json:"inline_attachment_media,omitempty"`
+	EnableTTSCommand       *bool              `json:"enable_tts_command,omitempty"`
+	MessageDisplayCompact  *bool              `json:"message_display_compact,omitempty"`
+	ShowCurrentGame        *bool              `json:"show_current_game,omitempty"`
+	ConvertEmoticons       *bool              `json:"convert_emoticons,omitempty"`
+	Locale                 string             `json:"locale,omitempty"`
+	Theme                  string             `json:"theme,omitempty"`
+	GuildPositions         []string           `json:"guild_positions,omitempty"`
+	RestrictedGuilds       []string           `json:"restricted_guilds,omitempty"`
+	FriendSourceFlags      *FriendSourceFlags `json:"friend_source_flags,omitempty"`
+	Status                 Status             `json:"status,omitempty"`
+	DetectPlatformAccounts *bool              `json:"detect_platform_accounts,omitempty"`
+	DeveloperMode          *bool              `json:"developer_mode,omitempty"`
+}
+
 // Status type definition
 type Status string
 
@@ -1205,11 +1552,24 @@ type FriendSourceFlags struct {
 	MutualFriends bool `json:"mutual_friends"`
 }
 
+// RelationshipType is the type of a Relationship.
+type RelationshipType int
+
+// Valid RelationshipType values
+const (
+	RelationshipTypeNone            RelationshipType = 0
+	RelationshipTypeFriend          RelationshipType = 1
+	RelationshipTypeBlocked         RelationshipType = 2
+	RelationshipTypeIncomingRequest RelationshipType = 3
+	RelationshipTypeOutgoingRequest RelationshipType = 4
+	RelationshipTypeImplicit        RelationshipType = 5
+)
+
 // A Relationship between the logged in user and Relationship.User
 type Relationship struct {
-	User *User  `json:"user"`
-	Type int    `json:"type"` // 1 = friend, 2 = blocked, 3 = incoming friend req, 4 = sent friend req
-	ID   string `json:"id"`
+	User *User            `json:"user"`
+	Type RelationshipType `json:"type"`
+	ID   string           `json:"id"`
 }
 
 // A TooManyRequests struct holds information received from Discord
@@ -1247,6 +1607,28 @@ type ReadState struct {
 	ID            string `json:"id"`
 }
 
+// ReadStates is a list of read states. It is unmarshalled either from a list,
+// or from the versioned object which holds the read states in READY.
+type ReadStates []*ReadState
+
+// UnmarshalJSON is a helper function to unmarshal ReadStates.
+func (r *ReadStates) UnmarshalJSON(data []byte) error {
+	return unmarshalEntries(data, (*[]*ReadState)(r))
+}
+
+// unmarshalEntries unmarshals a list which READY sends either as is, or as
+// the entries of a versioned object.
+func unmarshalEntries(data []byte, v interface{}) error {
+	if len(data) > 0 && data[0] == '{' {
+		entries := struct {
+			Entries interface{} `json:"entries"`
+		}{v}
+		return json.Unmarshal(data, &entries)
+	}
+
+	return json.Unmarshal(data, v)
+}
+
 // An Ack is used to ack messages
 type Ack struct {
 	Token string `json:"token"`
@@ -1264,6 +1646,70 @@ type GuildBan struct {
 	User   *User  `json:"user"`
 }
 
+// A MemberList is the member list shown next to the channels of a guild to
+// user accounts. Channels which are visible to the same members share a list.
+type MemberList struct {
+	ID          string
+	GuildID     string
+	MemberCount int
+	OnlineCount int
+
+	// The groups of the list, by role or by status, with their number of
+	// members.
+	Groups []*MemberListGroup
+
+	// The items of the list, in order: each group is followed by its
+	// members. Items which weren't received yet are nil.
+	Items []*MemberListItem
+}
+
+// A MemberListGroup is a group of a MemberList, which is either the ID of a
+// hoisted role, or "online" or "offline".
+type MemberListGroup struct {
+	ID    string `json:"id"`
+	Count int    `json:"count"`
+}
+
+// A MemberListItem is an item of a MemberList, either a group header or a
+// member.
+type MemberListItem struct {
+	Group  *MemberListGroup  `json:"group,omitempty"`
+	Member *MemberListMember `json:"member,omitempty"`
+}
+
+// A MemberListMember is a member of a MemberList, along with their presence.
+type MemberListMember struct {
+	*Member
+	Presence *Presence `json:"presence,omitempty"`
+}
+
+// MemberListOpType is the type of a MemberListOp.
+type MemberListOpType string
+
+// Valid MemberListOpType values
+const (
+	// MemberListOpSync replaces the items of a range.
+	MemberListOpSync MemberListOpType = "SYNC"
+	// MemberListOpInsert inserts an item at an index.
+	MemberListOpInsert MemberListOpType = "INSERT"
+	// MemberListOpUpdate replaces the item at an index.
+	MemberListOpUpdate MemberListOpType = "UPDATE"
+	// MemberListOpDelete removes the item at an index.
+	MemberListOpDelete MemberListOpType = "DELETE"
+	// MemberListOpInvalidate forgets the items of a range, which is no
+	// longer subscribed to.
+	MemberListOpInvalidate MemberListOpType = "INVALIDATE"
+)
+
+// A MemberListOp is an operation to apply to a MemberList.
+type MemberListOp struct {
+	Op    MemberListOpType  `json:"op"`
+	Range [2]int            `json:"range"`
+	Items []*MemberListItem `json:"items"`
+	Index int               `json:"index"`
+	Item  *MemberListItem   `json:"item"`
+}
+
 // A GuildEmbed stores data for a guild embed.
 type GuildEmbed struct {
 	Enabled   bool   `json:"enabled"`
@@ -1277,6 +1723,8 @@ type GuildAuditLog struct {
 	Users           []*User          `json:"users,omitempty"`
 	AuditLogEntries []*AuditLogEntry `json:"audit_log_entries"`
 	Integrations    []*Integration   `json:"integrations"`
+
+	AutoModerationRules []*AutoModerationRule `json:"auto_moderation_rules"`
 }
 
 // AuditLogEntry for a GuildAuditLog
@@ -1459,6 +1907,9 @@ type AuditLogOptions struct {
 	ID               string               `json:"id"`
 	Type             *AuditLogOptionsType `json:"type"`
 	RoleName         string               `json:"role_name"`
+
+	AutoModerationRuleName        string `json:"auto_moderation_rule_name"`
+	AutoModerationRuleTriggerType string `json:"auto_moderation_rule_trigger_type"`
 }
 
 // AuditLogOptionsType of the AuditLogOption
@@ -1535,6 +1986,13 @@ const (
 	AuditLogActionThreadCreate AuditLogAction = 110
 	AuditLogActionThreadUpdate AuditLogAction = 111
 	AuditLogActionThreadDelete AuditLogAction = 112
+
+	AuditLogActionAutoModerationRuleCreate                AuditLogAction = 140
+	AuditLogActionAutoModerationRuleUpdate                AuditLogAction = 141
+	AuditLogActionAutoModerationRuleDelete                AuditLogAction = 142
+	AuditLogActionAutoModerationBlockMessage              AuditLogAction = 143
+	AuditLogActionAutoModerationFlagToChannel             AuditLogAction = 144
+	AuditLogActionAutoModerationUserCommunicationDisabled AuditLogAction = 145
 )
 
 // A UserGuildSettingsChannelOverride stores data for a channel override for a users guild settings.
@@ -1554,6 +2012,15 @@ type UserGuildSettings struct {
 	ChannelOverrides     []*UserGuildSettingsChannelOverride `json:"channel_overrides"`
 }
 
+// UserGuildSettingsList is a list of guild settings. It is unmarshalled either
+// from a list, or from the versioned object which holds the settings in READY.
+type UserGuildSettingsList []*UserGuildSettings
+
+// UnmarshalJSON is a helper function to unmarshal UserGuildSettingsList.
+func (l *UserGuildSettingsList) UnmarshalJSON(data []byte) error {
+	return unmarshalEntries(data, (*[]*UserGuildSettings)(l))
+}
+
 // A UserGuildSettingsEdit stores data for editing UserGuildSettings
 type UserGuildSettingsEdit struct {
 	SupressEveryone      bool                                         `json:"suppress_everyone"`
@@ -1567,6 +2034,62 @@ type UserGuildSettingsEdit struct {
 type APIErrorMessage struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+
+	// Errors holds the errors of invalid request fields by path,
+	// e.g. "embeds.0.description".
+	Errors map[string][]*APIFieldError `json:"-"`
+}
+
+// An APIFieldError is the error of an invalid request field.
+type APIFieldError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// UnmarshalJSON is a helper function to unmarshal an APIErrorMessage,
+// flattening the tree of field errors into Errors.
+func (m *APIErrorMessage) UnmarshalJSON(data []byte) error {
+	type apiErrorMessage APIErrorMessage
+	var v struct {
+		apiErrorMessage
+		Errors json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*m = APIErrorMessage(v.apiErrorMessage)
+	if len(v.Errors) > 0 {
+		m.Errors = make(map[string][]*APIFieldError)
+		flattenAPIFieldErrors(v.Errors, "", m.Errors)
+	}
+
+	return nil
+}
+
+// flattenAPIFieldErrors adds the errors of a node of the field errors tree,
+// and of its children, to errs. Field errors are listed under "_errors", other
+// keys are field names or indexes.
+func flattenAPIFieldErrors(node json.RawMessage, path string, errs map[string][]*APIFieldError) {
+	var children map[string]json.RawMessage
+	if json.Unmarshal(node, &children) != nil {
+		return
+	}
+
+	for key, child := range children {
+		if key == "_errors" {
+			var fieldErrs []*APIFieldError
+			if json.Unmarshal(child, &fieldErrs) == nil {
+				errs[path] = append(errs[path], fieldErrs...)
+			}
+			continue
+		}
+
+		if path != "" {
+			key = path + "." + key
+		}
+		flattenAPIFieldErrors(child, key, errs)
+	}
 }
 
 // MessageReaction stores the data for a message reaction.
@@ -1699,16 +2222,30 @@ type Identify struct {
 	Shard          *[2]int             `json:"shard,omitempty"`
 	Presence       GatewayStatusUpdate `json:"presence,omitempty"`
 	Intents        Intent              `json:"intents,omitempty"`
+
+	// Sent by user clients only, see IdentifyPreset.
+	Capabilities Capability   `json:"capabilities,omitempty"`
+	ClientState  *ClientState `json:"client_state,omitempty"`
 }
 
 // IdentifyProperties contains the "properties" portion of an Identify packet
 // https://discord.com/developers/docs/topics/gateway#identify-identify-connection-properties
+//
+// User clients send more properties, which are also sent with every REST
+// request in the X-Super-Properties header when ClientBuildNumber is set.
 type IdentifyProperties struct {
-	OS              string `json:"$os"`
-	Browser         string `json:"$browser"`
-	Device          string `json:"$device"`
-	Referer         string `json:"$referer"`
-	ReferringDomain string `json:"$referring_domain"`
+	OS              string `json:"os"`
+	Browser         string `json:"browser"`
+	Device          string `json:"device"`
+	Referer         string `json:"referrer,omitempty"`
+	ReferringDomain string `json:"referring_domain,omitempty"`
+
+	SystemLocale      string `json:"system_locale,omitempty"`
+	BrowserUserAgent  string `json:"browser_user_agent,omitempty"`
+	BrowserVersion    string `json:"browser_version,omitempty"`
+	OSVersion         string `json:"os_version,omitempty"`
+	ReleaseChannel    string `json:"release_channel,omitempty"`
+	ClientBuildNumber int    `json:"client_build_number,omitempty"`
 }
 
 // Constants for the different bit offsets of text channel permissions
@@ -1955,6 +2492,9 @@ const (
 	ErrCodeStickerFrameRateOutOfRange                          = 170006
 	ErrCodeStickerAnimationDurationExceedsMaximumOfFiveSeconds = 170007
 
+	ErrCodeTwoFactorRequired    = 60003
+	ErrCodeInvalidTwoFactorCode = 60008
+
 	ErrCodeCannotUpdateAFinishedEvent             = 180000
 	ErrCodeFailedToCreateStageNeededForStageEvent = 180002
 )
@@ -1983,6 +2523,9 @@ const (
 	IntentMessageContent         Intent = 1 << 15
 	IntentGuildScheduledEvents   Intent = 1 << 16
 
+	IntentAutoModerationConfiguration Intent = 1 << 20
+	IntentAutoModerationExecution     Intent = 1 << 21
+
 	// TODO: remove when compatibility is not needed
 
 	IntentsGuilds                 Intent = 1 << 0
@@ -2016,7 +2559,9 @@ const (
 		IntentDirectMessages |
 		IntentDirectMessageReactions |
 		IntentDirectMessageTyping |
-		IntentGuildScheduledEvents
+		IntentGuildScheduledEvents |
+		IntentAutoModerationConfiguration |
+		IntentAutoModerationExecution
 
 	IntentsAll = IntentsAllWithoutPrivileged |
 		IntentGuildMembers |
@@ -21,7 +21,7 @@ var eventHandlerTmpl = template.Must(template.New("eventHandler").Funcs(template
 }).Parse(`// Code generated by \"eventhandlers\"; DO NOT EDIT
 // See events.go
 
-package discordgo
+package astatine
 
 // Following are all the event types.
 // Event type values are used to match the events returned by Discord.
@@ -104,7 +104,7 @@ func constCase(name string) string {
 
 func isDiscordEvent(name string) bool {
 	switch {
-	case name == "Connect", name == "Disconnect", name == "Event", name == "RateLimit", name == "Interface":
+	case name == "Connect", name == "Disconnect", name == "Event", name == "RateLimit", name == "RequestRetry", name == "Interface":
 		return false
 	default:
 		return true
@@ -7,6 +7,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/textproto"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -77,6 +78,51 @@ func MultipartBodyWithJSON(data interface{}, files []*File) (requestContentType
 	return bodywriter.FormDataContentType(), body.Bytes(), nil
 }
 
+// multipartBodyWithFields returns the contentType and body for a discord
+// request with form fields, and a file sent as the form field "file".
+// fields : The form fields, which are written in the order of their names
+// file   : The file to include in the request
+func multipartBodyWithFields(fields map[string]string, file *File) (requestContentType string, requestBody []byte, err error) {
+	body := &bytes.Buffer{}
+	bodywriter := multipart.NewWriter(body)
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err = bodywriter.WriteField(name, fields[name]); err != nil {
+			return
+		}
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(file.Name)))
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h.Set("Content-Type", contentType)
+
+	p, err := bodywriter.CreatePart(h)
+	if err != nil {
+		return
+	}
+
+	if _, err = io.Copy(p, file.Reader); err != nil {
+		return
+	}
+
+	err = bodywriter.Close()
+	if err != nil {
+		return
+	}
+
+	return bodywriter.FormDataContentType(), body.Bytes(), nil
+}
+
 func avatarURL(avatarHash, defaultAvatarURL, staticAvatarURL, animatedAvatarURL, size string) string {
 	var URL string
 	if avatarHash == "" {
@@ -121,7 +121,7 @@ func (v *VoiceConnection) ChangeChannel(channelID string, mute, deaf bool) (err
 
 	data := voiceChannelJoinOp{4, voiceChannelJoinData{&v.GuildID, &channelID, mute, deaf}}
 	v.wsMutex.Lock()
-	err = v.session.wsConn.WriteJSON(data)
+	err = v.session.writeGateway(v.session.wsConn, data)
 	v.wsMutex.Unlock()
 	if err != nil {
 		return
@@ -143,7 +143,7 @@ func (v *VoiceConnection) Disconnect() (err error) {
 	if v.sessionID != "" {
 		data := voiceChannelJoinOp{4, voiceChannelJoinData{&v.GuildID, nil, true, true}}
 		v.session.wsMutex.Lock()
-		err = v.session.wsConn.WriteJSON(data)
+		err = v.session.writeGateway(v.session.wsConn, data)
 		v.session.wsMutex.Unlock()
 		v.sessionID = ""
 	}
@@ -904,7 +904,7 @@ func (v *VoiceConnection) reconnect() {
 		// Send a OP4 with a nil channel to disconnect
 		data := voiceChannelJoinOp{4, voiceChannelJoinData{&v.GuildID, nil, true, true}}
 		v.session.wsMutex.Lock()
-		err = v.session.wsConn.WriteJSON(data)
+		err = v.session.writeGateway(v.session.wsConn, data)
 		v.session.wsMutex.Unlock()
 		if err != nil {
 			v.log(LogError, "error sending disconnect packet, %s", err)
@@ -12,12 +12,16 @@ package astatine
 
 import (
 	"bytes"
+	"compress/flate"
 	"compress/zlib"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	netHttp "net/http"
+	"net/url"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -69,23 +73,33 @@ func (s *Session) Open() error {
 		if err != nil {
 			return err
 		}
-
-		// Add the version and encoding to the URL
-		s.gateway = s.gateway + "?v=" + http.APIVersion + "&encoding=json"
 	}
 
+	// A session is resumed when there is one to resume, otherwise a new
+	// one is identified.
+	sequence := atomic.LoadInt64(s.sequence)
+	resume := s.sessionID != "" || sequence != 0
+
 	// Connect to the Gateway
-	s.log(LogInformational, "connecting to gateway %s", s.gateway)
+	gateway := s.gatewayURL(resume)
+	s.log(LogInformational, "connecting to gateway %s", gateway)
 	header := netHttp.Header{}
 	header.Add("accept-encoding", "zlib")
-	s.wsConn, _, err = websocket.DefaultDialer.Dial(s.gateway, header)
+	s.wsConn, _, err = websocket.DefaultDialer.Dial(gateway, header)
 	if err != nil {
-		s.log(LogError, "error connecting to gateway %s, %s", s.gateway, err)
-		s.gateway = "" // clear cached gateway
-		s.wsConn = nil // Just to be safe.
+		s.log(LogError, "error connecting to gateway %s, %s", gateway, err)
+		s.gateway = ""       // clear cached gateway
+		s.resumeGateway = "" // and fall back to it next time
+		s.wsConn = nil       // Just to be safe.
 		return err
 	}
 
+	// Every connection starts a new zlib-stream.
+	s.zlibStream = nil
+	if s.TransportCompression {
+		s.zlibStream = &zlibStream{}
+	}
+
 	s.wsConn.SetCloseHandler(func(code int, text string) error {
 		return nil
 	})
@@ -102,11 +116,7 @@ func (s *Session) Open() error {
 
 	// The first response from Discord should be an Op 10 (Hello) Packet.
 	// When processed by onEvent the heartbeat goroutine will be started.
-	mt, m, err := s.wsConn.ReadMessage()
-	if err != nil {
-		return err
-	}
-	e, err := s.onEvent(mt, m)
+	e, err := s.nextEvent(s.wsConn)
 	if err != nil {
 		return err
 	}
@@ -124,8 +134,7 @@ func (s *Session) Open() error {
 
 	// Now we send either an Op 2 Identity if this is a brand new
 	// connection or Op 6 Resume if we are resuming an existing connection.
-	sequence := atomic.LoadInt64(s.sequence)
-	if s.sessionID == "" && sequence == 0 {
+	if !resume {
 
 		// Send Op 2 Identity Packet
 		err = s.identify()
@@ -145,7 +154,7 @@ func (s *Session) Open() error {
 
 		s.log(LogInformational, "sending resume packet to gateway")
 		s.wsMutex.Lock()
-		err = s.wsConn.WriteJSON(p)
+		err = s.writeGateway(s.wsConn, p)
 		s.wsMutex.Unlock()
 		if err != nil {
 			err = fmt.Errorf("error sending gateway resume packet, %s, %s", s.gateway, err)
@@ -169,11 +178,7 @@ func (s *Session) Open() error {
 	}
 
 	// Now Discord should send us a READY or RESUMED packet.
-	mt, m, err = s.wsConn.ReadMessage()
-	if err != nil {
-		return err
-	}
-	e, err = s.onEvent(mt, m)
+	e, err = s.nextEvent(s.wsConn)
 	if err != nil {
 		return err
 	}
@@ -206,6 +211,44 @@ func (s *Session) Open() error {
 	return nil
 }
 
+// gatewayURL returns the URL used to connect to the gateway, with the API
+// version, encoding and compression added to it. When resuming, the resume
+// gateway given in the READY event is used if there is one.
+func (s *Session) gatewayURL(resume bool) string {
+	gateway := s.gateway
+	if resume && s.resumeGateway != "" {
+		gateway = s.resumeGateway
+		if !strings.HasSuffix(gateway, "/") {
+			gateway += "/"
+		}
+	}
+
+	v := url.Values{}
+	v.Set("v", http.APIVersion)
+	v.Set("encoding", s.gatewayCodec().Encoding())
+	if s.TransportCompression {
+		v.Set("compress", "zlib-stream")
+	}
+
+	return gateway + "?" + v.Encode()
+}
+
+// nextEvent reads messages from the websocket connection until a whole
+// event has been received, and returns it after it was handled by onEvent.
+func (s *Session) nextEvent(wsConn *websocket.Conn) (*Event, error) {
+	for {
+		mt, m, err := wsConn.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := s.onEvent(mt, m)
+		if e != nil || err != nil {
+			return e, err
+		}
+	}
+}
+
 // listen polls the websocket connection for events, it will stop when the
 // listening channel is closed, or an error occurs.
 func (s *Session) listen(wsConn *websocket.Conn, listening <-chan interface{}) {
@@ -296,7 +339,7 @@ func (s *Session) heartbeat(wsConn *websocket.Conn, listening <-chan interface{}
 		s.log(LogDebug, "sending gateway websocket heartbeat seq %d", sequence)
 		s.wsMutex.Lock()
 		s.LastHeartbeatSent = time.Now().UTC()
-		err = wsConn.WriteJSON(heartbeatOp{1, sequence})
+		err = s.writeGateway(wsConn, heartbeatOp{1, sequence})
 		s.wsMutex.Unlock()
 		if err != nil || time.Now().UTC().Sub(last) > (heartbeatIntervalMsec*FailedHeartbeatAcks) {
 			if err != nil {
@@ -403,7 +446,7 @@ func (s *Session) UpdateStatusComplex(usd UpdateStatusData) (err error) {
 	}
 
 	s.wsMutex.Lock()
-	err = s.wsConn.WriteJSON(updateStatusOp{3, usd})
+	err = s.writeGateway(s.wsConn, updateStatusOp{3, usd})
 	s.wsMutex.Unlock()
 
 	return
@@ -465,15 +508,133 @@ func (s *Session) requestGuildMembers(data requestGuildMembersData) (err error)
 	}
 
 	s.wsMutex.Lock()
-	err = s.wsConn.WriteJSON(requestGuildMembersOp{8, data})
+	err = s.writeGateway(s.wsConn, requestGuildMembersOp{8, data})
 	s.wsMutex.Unlock()
 
 	return
 }
 
+// GuildSubscribeData holds the data of a guild subscription, which user
+// accounts send to receive events of large guilds, such as their member list.
+type GuildSubscribeData struct {
+	GuildID    string `json:"guild_id"`
+	Typing     bool   `json:"typing,omitempty"`
+	Threads    bool   `json:"threads,omitempty"`
+	Activities bool   `json:"activities,omitempty"`
+
+	// The IDs of members whose presence and member updates to receive.
+	Members []string `json:"members,omitempty"`
+
+	// The ranges of the member list of each channel to receive, as
+	// GuildMemberListUpdate events. Ranges are inclusive, e.g. [0, 99].
+	Channels map[string][][2]int `json:"channels,omitempty"`
+}
+
+type guildSubscribeOp struct {
+	Op   int                `json:"op"`
+	Data GuildSubscribeData `json:"d"`
+}
+
+// GuildSubscribe subscribes a user session to events of a guild, see
+// GuildSubscribeData.
+func (s *Session) GuildSubscribe(data GuildSubscribeData) (err error) {
+	s.log(LogInformational, "called")
+
+	s.RLock()
+	defer s.RUnlock()
+	if s.wsConn == nil {
+		return ErrWSNotFound
+	}
+
+	s.wsMutex.Lock()
+	err = s.writeGateway(s.wsConn, guildSubscribeOp{14, data})
+	s.wsMutex.Unlock()
+
+	return
+}
+
+// GuildMemberListSubscribe subscribes a user session to ranges of the member
+// list of a guild channel. The gateway responds with GuildMemberListUpdate
+// events, which the State applies to its MemberList.
+// guildID   : The ID of a Guild
+// channelID : The ID of a Channel of the guild
+// ranges    : Inclusive ranges of the list, usually by hundreds: [0, 99], [100, 199]...
+func (s *Session) GuildMemberListSubscribe(guildID, channelID string, ranges ...[2]int) (err error) {
+	return s.GuildSubscribe(GuildSubscribeData{
+		GuildID:    guildID,
+		Typing:     true,
+		Threads:    true,
+		Activities: true,
+		Channels:   map[string][][2]int{channelID: ranges},
+	})
+}
+
+// zlibStreamSuffix is the Z_SYNC_FLUSH marker that ends every message of a
+// zlib-stream compressed gateway connection.
+var zlibStreamSuffix = []byte{0x00, 0x00, 0xff, 0xff}
+
+// zlibStreamWindow is the size of the deflate window shared between messages.
+const zlibStreamWindow = 32 * 1024
+
+// zlibStream inflates the messages of a zlib-stream compressed gateway
+// connection. All messages are part of one deflate stream, so the data
+// inflated so far is kept as the dictionary for the next message.
+type zlibStream struct {
+	buf    bytes.Buffer
+	header bool
+	window []byte
+	reader io.ReadCloser
+}
+
+// inflate buffers a websocket message. Once the buffered messages end with
+// the sync flush suffix, they are inflated and the payload is returned.
+// Until then, inflate returns nil.
+func (z *zlibStream) inflate(message []byte) ([]byte, error) {
+	z.buf.Write(message)
+	if !bytes.HasSuffix(z.buf.Bytes(), zlibStreamSuffix) {
+		return nil, nil
+	}
+	defer z.buf.Reset()
+
+	data := z.buf.Bytes()
+
+	// Only the first message carries the zlib header, everything after it
+	// is raw deflate data.
+	if !z.header {
+		if len(data) < 2 || data[0]&0x0f != 8 {
+			return nil, zlib.ErrHeader
+		}
+		data = data[2:]
+		z.header = true
+	}
+
+	if z.reader == nil {
+		z.reader = flate.NewReaderDict(bytes.NewReader(data), z.window)
+	} else if err := z.reader.(flate.Resetter).Reset(bytes.NewReader(data), z.window); err != nil {
+		return nil, err
+	}
+
+	// The stream never ends, so the reader runs out of input right after
+	// the sync flush and reports it as an unexpected EOF.
+	payload, err := ioutil.ReadAll(z.reader)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+
+	z.window = append(z.window, payload...)
+	if len(z.window) > zlibStreamWindow {
+		z.window = z.window[len(z.window)-zlibStreamWindow:]
+	}
+
+	return payload, nil
+}
+
 // onEvent is the "event handler" for all messages received on the
 // Discord Gateway API websocket connection.
 //
+// When TransportCompression is enabled, a message may only hold part of an
+// event. In that case onEvent buffers it and returns a nil Event.
+//
 // If you use the AddHandler() function to register a handler for a
 // specific event this function will pass the event along to that handler.
 //
@@ -486,7 +647,20 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 	reader = bytes.NewBuffer(message)
 
 	// If this is a compressed message, uncompress it.
-	if messageType == websocket.BinaryMessage {
+	if messageType == websocket.BinaryMessage && s.zlibStream != nil {
+
+		payload, err2 := s.zlibStream.inflate(message)
+		if err2 != nil {
+			s.log(LogError, "error inflating websocket message, %s", err2)
+			return nil, err2
+		}
+
+		if payload == nil {
+			return nil, nil
+		}
+
+		reader = bytes.NewReader(payload)
+	} else if messageType == websocket.BinaryMessage && s.gatewayCodec() == GatewayCodecJSON {
 
 		z, err2 := zlib.NewReader(reader)
 		if err2 != nil {
@@ -504,10 +678,15 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 		reader = z
 	}
 
+	payload, err := ioutil.ReadAll(reader)
+	if err != nil {
+		s.log(LogError, "error reading websocket message, %s", err)
+		return nil, err
+	}
+
 	// Decode the event into an Event struct.
-	var e *Event
-	decoder := json.NewDecoder(reader)
-	if err = decoder.Decode(&e); err != nil {
+	e := &Event{}
+	if err = s.gatewayCodec().Unmarshal(payload, e); err != nil {
 		s.log(LogError, "error decoding websocket message, %s", err)
 		return e, err
 	}
@@ -519,7 +698,7 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 	if e.Operation == 1 {
 		s.log(LogInformational, "sending heartbeat in response to Op1")
 		s.wsMutex.Lock()
-		err = s.wsConn.WriteJSON(heartbeatOp{1, atomic.LoadInt64(s.sequence)})
+		err = s.writeGateway(s.wsConn, heartbeatOp{1, atomic.LoadInt64(s.sequence)})
 		s.wsMutex.Unlock()
 		if err != nil {
 			s.log(LogError, "error sending heartbeat in response to Op1")
@@ -687,7 +866,7 @@ func (s *Session) ChannelVoiceJoinManual(gID, cID string, mute, deaf bool) (err
 	// Send the request to Discord that we want to join the voice channel
 	data := voiceChannelJoinOp{4, voiceChannelJoinData{&gID, channelID, mute, deaf}}
 	s.wsMutex.Lock()
-	err = s.wsConn.WriteJSON(data)
+	err = s.writeGateway(s.wsConn, data)
 	s.wsMutex.Unlock()
 	return
 }
@@ -786,9 +965,16 @@ func (s *Session) identify() error {
 
 	// Send Identify packet to Discord
 	op := identifyOp{2, s.Identify}
+
+	// Payload compression can't be combined with transport compression, and
+	// is only supported for JSON payloads.
+	if s.TransportCompression || s.gatewayCodec() != GatewayCodecJSON {
+		op.Data.Compress = false
+	}
+
 	s.log(LogDebug, "Identify Packet: \n%#v", op)
 	s.wsMutex.Lock()
-	err := s.wsConn.WriteJSON(op)
+	err := s.writeGateway(s.wsConn, op)
 	s.wsMutex.Unlock()
 
 	return err
@@ -0,0 +1,52 @@
+package astatine
+
+import (
+	"bytes"
+	"compress/zlib"
+	"testing"
+)
+
+func TestZlibStreamInflate(t *testing.T) {
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+
+	// The second payload repeats the first so that it can only be inflated
+	// with the window kept from the first message.
+	payloads := []string{
+		`{"op":10,"d":{"heartbeat_interval":41250}}`,
+		`{"op":10,"d":{"heartbeat_interval":41250}}{"op":11}`,
+	}
+
+	var messages [][]byte
+	for _, p := range payloads {
+		w.Write([]byte(p))
+		w.Flush()
+		messages = append(messages, append([]byte(nil), compressed.Bytes()...))
+		compressed.Reset()
+	}
+
+	z := &zlibStream{}
+
+	got, err := z.inflate(messages[0])
+	if err != nil {
+		t.Fatalf("inflate returned error: %v", err)
+	}
+	if string(got) != payloads[0] {
+		t.Errorf("inflate returned %q, want %q", got, payloads[0])
+	}
+
+	// Split the second message over two frames.
+	half := len(messages[1]) / 2
+	got, err = z.inflate(messages[1][:half])
+	if err != nil || got != nil {
+		t.Fatalf("inflate of a partial message returned %q, %v, want nil, nil", got, err)
+	}
+
+	got, err = z.inflate(messages[1][half:])
+	if err != nil {
+		t.Fatalf("inflate returned error: %v", err)
+	}
+	if string(got) != payloads[1] {
+		t.Errorf("inflate returned %q, want %q", got, payloads[1])
+	}
+}